@@ -239,7 +239,7 @@ func validateRounds(t *testing.T, cosmosClient *client.Client, ocrAddress types.
 	// TODO(BCI-1767): this needs to be able to support different readers
 	ocrLogger, err := relaylogger.New()
 	require.NoError(t, err, "Failed to create OCR relay logger")
-	ocrReader := cosmwasm.NewOCR2Reader(ocrAddress, cosmosClient, ocrLogger)
+	ocrReader := cosmwasm.NewOCR2Reader(ocrAddress, params.Bech32Prefix(bech32Prefix), cosmosClient, ocrLogger)
 
 	type TransmissionDetails struct {
 		ConfigDigest    ocrtypes.ConfigDigest
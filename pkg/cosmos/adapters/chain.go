@@ -12,7 +12,13 @@ type Chain interface {
 
 	ID() string
 	Config() config.Config
+	// UpdateConfig validates and applies cfg without restarting the chain.
+	UpdateConfig(cfg *config.TOMLConfig) error
 	TxManager() TxManager
+	// HeadTracker returns the chain-wide source of the latest block head.
+	HeadTracker() client.HeadReader
+	// LightBlockVerifier returns the light client of the chain, or nil unless it is enabled.
+	LightBlockVerifier() client.LightBlockVerifier
 	// Reader returns a new Reader. If nodeName is provided, the underlying client must use that node.
 	Reader(nodeName string) (client.Reader, error)
 }
@@ -0,0 +1,90 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/jpillora/backoff"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/client"
+)
+
+// ConfigNotifier subscribes to txs which change a contract config, and signals each change on Notify.
+// It backs the Notify method of the ContractConfigTracker implementations, so that libocr picks up
+// new configs within a block rather than on its polling interval.
+type ConfigNotifier struct {
+	subscriber client.EventSubscriber
+	query      string
+	handle     func(ctx context.Context, tx cmttypes.EventDataTx) bool
+	lggr       logger.Logger
+
+	notify     chan struct{}
+	stop, done chan struct{}
+}
+
+// NewConfigNotifier returns a ConfigNotifier for txs matching query.
+// handle is called for each matching tx, and reports whether it changed the config.
+func NewConfigNotifier(subscriber client.EventSubscriber, query string, handle func(ctx context.Context, tx cmttypes.EventDataTx) bool, lggr logger.Logger) *ConfigNotifier {
+	return &ConfigNotifier{
+		subscriber: subscriber,
+		query:      query,
+		handle:     handle,
+		lggr:       logger.Named(lggr, "ConfigNotifier"),
+		notify:     make(chan struct{}, 1),
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+}
+
+// Notify returns a channel which receives a value when the config changes. It is never closed.
+func (n *ConfigNotifier) Notify() <-chan struct{} {
+	return n.notify
+}
+
+func (n *ConfigNotifier) Start() error {
+	go n.run()
+	return nil
+}
+
+func (n *ConfigNotifier) Close() error {
+	close(n.stop)
+	<-n.done
+	return nil
+}
+
+func (n *ConfigNotifier) run() {
+	defer close(n.done)
+	ctx, cancel := utils.ContextFromChan(n.stop)
+	defer cancel()
+	b := backoff.Backoff{Min: time.Second, Max: time.Minute, Jitter: true}
+	for {
+		txs, err := n.subscriber.SubscribeTxs(ctx, n.query)
+		if err != nil {
+			// libocr keeps polling in the meantime, so we just retry
+			n.lggr.Errorw("Failed to subscribe to config changes", "query", n.query, "err", err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(b.Duration()):
+				continue
+			}
+		}
+		b.Reset()
+		for tx := range txs {
+			if !n.handle(ctx, tx) {
+				continue
+			}
+			n.lggr.Infow("Config changed", "height", tx.Height)
+			select {
+			case n.notify <- struct{}{}:
+			default: // a notification is already pending
+			}
+		}
+		// txs is only closed once ctx is done
+		return
+	}
+}
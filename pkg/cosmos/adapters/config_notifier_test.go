@@ -0,0 +1,98 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/client"
+)
+
+var _ client.EventSubscriber = (*fakeSubscriber)(nil)
+
+type fakeSubscriber struct {
+	failures atomic.Int32
+	txs      chan cmttypes.EventDataTx
+}
+
+func (f *fakeSubscriber) SubscribeNewBlockHeaders(ctx context.Context) (<-chan cmttypes.EventDataNewBlockHeader, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f *fakeSubscriber) SubscribeTxs(ctx context.Context, query string) (<-chan cmttypes.EventDataTx, error) {
+	if f.failures.Add(-1) >= 0 {
+		return nil, errors.New("connection refused")
+	}
+	out := make(chan cmttypes.EventDataTx)
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case tx := <-f.txs:
+				select {
+				case out <- tx:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out, nil
+}
+
+func (f *fakeSubscriber) SubscribeTx(ctx context.Context, txHash string) (<-chan cmttypes.EventDataTx, error) {
+	return nil, errors.New("not implemented")
+}
+
+func TestConfigNotifier(t *testing.T) {
+	sub := &fakeSubscriber{txs: make(chan cmttypes.EventDataTx)}
+	sub.failures.Store(1) // first subscription attempt fails
+	n := NewConfigNotifier(sub, "wasm-set_config._contract_address='wasm1'", func(ctx context.Context, tx cmttypes.EventDataTx) bool {
+		return tx.Height%2 == 0
+	}, logger.Test(t))
+	require.NoError(t, n.Start())
+	t.Cleanup(func() { require.NoError(t, n.Close()) })
+
+	send := func(height int64) {
+		select {
+		case sub.txs <- cmttypes.EventDataTx{TxResult: abci.TxResult{Height: height}}:
+		case <-time.After(tests.WaitTimeout(t)):
+			t.Fatal("timed out sending tx")
+		}
+	}
+
+	send(1) // ignored by handle
+	select {
+	case <-n.Notify():
+		t.Fatal("unexpected notification")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	send(2)
+	send(4) // coalesced with the pending notification
+	send(1)
+	send(1) // ensures 4 was handled
+	select {
+	case <-n.Notify():
+	case <-time.After(tests.WaitTimeout(t)):
+		t.Fatal("timed out waiting for notification")
+	}
+	select {
+	case <-n.Notify():
+		t.Fatal("notifications should be coalesced")
+	case <-time.After(100 * time.Millisecond):
+	}
+	assert.Equal(t, int32(-1), sub.failures.Load(), "should have subscribed twice")
+}
@@ -11,6 +11,8 @@ import (
 
 	"github.com/smartcontractkit/libocr/offchainreporting2/types"
 	"golang.org/x/crypto/blake2s"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
 )
 
 const ConfigDigestPrefixCosmos types.ConfigDigestPrefix = 2
@@ -20,12 +22,16 @@ var _ types.OffchainConfigDigester = (*OffchainConfigDigester)(nil)
 type OffchainConfigDigester struct {
 	chainID  string
 	contract cosmosSDK.AccAddress
+	bech32   params.Bech32Prefix
 }
 
-func NewOffchainConfigDigester(chainID string, contract cosmosSDK.AccAddress) OffchainConfigDigester {
+// NewOffchainConfigDigester returns a digester for the contract on chainID. Digests commit to the bech32 encoding
+// of the contract address, so bech32 must be the prefix of the chain for them to match those of the contract.
+func NewOffchainConfigDigester(chainID string, contract cosmosSDK.AccAddress, bech32 params.Bech32Prefix) OffchainConfigDigester {
 	return OffchainConfigDigester{
 		chainID:  chainID,
 		contract: contract,
+		bech32:   bech32,
 	}
 }
 
@@ -45,7 +51,7 @@ func (cd OffchainConfigDigester) ConfigDigest(cfg types.ContractConfig) (types.C
 		return digest, err
 	}
 
-	if _, err := buf.Write([]byte(cd.contract.String())); err != nil {
+	if _, err := buf.Write([]byte(cd.bech32.Address(cd.contract))); err != nil {
 		return digest, err
 	}
 
@@ -8,6 +8,9 @@ import (
 
 	"github.com/smartcontractkit/libocr/offchainreporting2/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
 )
 
 var testConfig = types.ContractConfig{
@@ -34,6 +37,7 @@ func TestConfigDigester(t *testing.T) {
 	d := NewOffchainConfigDigester(
 		"ibiza-808",
 		sdk.MustAccAddressFromBech32("wasm1cd65xyq076dm9cw3xxqtdh4d6ypzug0edd9958"),
+		"wasm",
 	)
 
 	digest, err := d.ConfigDigest(testConfig)
@@ -45,8 +49,23 @@ func TestConfigDigester_InvalidChainID(t *testing.T) {
 	d := NewOffchainConfigDigester(
 		strings.Repeat("a", 256), // chain ID is too long
 		sdk.MustAccAddressFromBech32("wasm1cd65xyq076dm9cw3xxqtdh4d6ypzug0edd9958"),
+		"wasm",
 	)
 
 	_, err := d.ConfigDigest(testConfig)
 	assert.Error(t, err)
 }
+
+func TestConfigDigester_Bech32Prefix(t *testing.T) {
+	contract := sdk.MustAccAddressFromBech32("wasm1cd65xyq076dm9cw3xxqtdh4d6ypzug0edd9958")
+	digest := func(prefix params.Bech32Prefix) types.ConfigDigest {
+		d, err := NewOffchainConfigDigester("ibiza-808", contract, prefix).ConfigDigest(testConfig)
+		require.NoError(t, err)
+		return d
+	}
+
+	// the empty prefix falls back to the global config
+	assert.Equal(t, digest("wasm"), digest(""))
+	// the contract digests its address on its own chain, whatever the global config
+	assert.NotEqual(t, digest("wasm"), digest("inj"))
+}
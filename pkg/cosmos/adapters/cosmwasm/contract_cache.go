@@ -80,16 +80,27 @@ func (cc *ContractCache) poll() {
 			return
 		case <-tick:
 			ctx, cancel := utils.ContextFromChan(cc.stop)
-			if err := cc.updateConfig(ctx); err != nil {
-				cc.lggr.Errorf("Failed to update config: %v", err)
-			}
-			if ctx.Err() != nil { // b/c client doesn't use ctx
+			// updated concurrently, so that the reader can batch their queries
+			var wg sync.WaitGroup
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				if err := cc.updateConfig(ctx); err != nil {
+					cc.lggr.Errorf("Failed to update config: %v", err)
+				}
+			}()
+			go func() {
+				defer wg.Done()
+				if err := cc.updateTransmission(ctx); err != nil {
+					cc.lggr.Errorf("Failed to update transmission: %v", err)
+				}
+			}()
+			wg.Wait()
+			err := ctx.Err()
+			cancel()
+			if err != nil { // b/c client doesn't use ctx
 				return
 			}
-			if err := cc.updateTransmission(ctx); err != nil {
-				cc.lggr.Errorf("Failed to update transmission: %v", err)
-			}
-			cancel()
 			tick = time.After(utils.WithJitter(cc.cfg.OCR2CachePollPeriod()))
 		}
 	}
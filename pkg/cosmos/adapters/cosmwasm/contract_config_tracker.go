@@ -2,37 +2,65 @@ package cosmwasm
 
 import (
 	"context"
+	"fmt"
 
+	cmttypes "github.com/cometbft/cometbft/types"
+	cosmosSDK "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/libocr/offchainreporting2/types"
 
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/adapters"
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/client"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
 )
 
 var _ types.ContractConfigTracker = (*ContractTracker)(nil)
 
 type ContractTracker struct {
 	*ContractCache
-	chainReader client.Reader
+	heads    client.HeadReader
+	notifier *adapters.ConfigNotifier
 }
 
-func NewContractTracker(chainReader client.Reader, contract *ContractCache) *ContractTracker {
-	return &ContractTracker{
+func NewContractTracker(subscriber client.EventSubscriber, heads client.HeadReader, contract *ContractCache, address cosmosSDK.AccAddress, bech32 params.Bech32Prefix, lggr logger.Logger) *ContractTracker {
+	ct := &ContractTracker{
 		ContractCache: contract,
-		chainReader:   chainReader,
+		heads:         heads,
+	}
+	query := fmt.Sprintf("wasm-set_config._contract_address='%s'", bech32.Address(address))
+	ct.notifier = adapters.NewConfigNotifier(subscriber, query, ct.onSetConfig, lggr)
+	return ct
+}
+
+// Start subscribes to set_config events. The ContractCache is started separately.
+func (ct *ContractTracker) Start() error {
+	return ct.notifier.Start()
+}
+
+func (ct *ContractTracker) Close() error {
+	return ct.notifier.Close()
+}
+
+// onSetConfig refreshes the cached config, since libocr reads it from the cache once notified.
+func (ct *ContractTracker) onSetConfig(ctx context.Context, _ cmttypes.EventDataTx) bool {
+	if err := ct.updateConfig(ctx); err != nil {
+		ct.lggr.Errorf("Failed to update config after set_config event: %v", err)
 	}
+	return true
 }
 
-// Unused, libocr will use polling
+// Notify emits when a set_config event is emitted by the contract.
+// libocr still polls, so missed events only delay the config change.
 func (ct *ContractTracker) Notify() <-chan struct{} {
-	return nil
+	return ct.notifier.Notify()
 }
 
-// TODO: seems heavy to fetch whole block rather than rpc.Status() -> SyncInfo.LatestBlockHeight
 // LatestBlockHeight returns the height of the most recent block in the chain.
 func (ct *ContractTracker) LatestBlockHeight(ctx context.Context) (blockHeight uint64, err error) {
-	b, err := ct.chainReader.LatestBlock()
+	head, err := ct.heads.LatestHead(ctx)
 	if err != nil {
 		return 0, err
 	}
-	return uint64(b.SdkBlock.Header.Height), nil
+	return uint64(head.Height), nil
 }
@@ -3,7 +3,6 @@ package cosmwasm
 import (
 	"context"
 	"encoding/base64"
-	"encoding/json"
 	"fmt"
 	"math/big"
 	"strconv"
@@ -11,37 +10,64 @@ import (
 	"time"
 
 	cosmosSDK "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/libocr/offchainreporting2/types"
 
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/client"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/contracts"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
 )
 
 type OCR2Reader struct {
 	address     cosmosSDK.AccAddress
+	bech32      params.Bech32Prefix
 	chainReader client.Reader
+	contract    *contracts.OCR2
 	lggr        logger.Logger
+
+	verified VerifiedState     // nil unless reads are verified, see NewVerifiedOCR2Reader
+	heads    client.HeadReader // nil unless reads are verified
 }
 
-func NewOCR2Reader(addess cosmosSDK.AccAddress, chainReader client.Reader, lggr logger.Logger) *OCR2Reader {
+func NewOCR2Reader(addess cosmosSDK.AccAddress, bech32 params.Bech32Prefix, chainReader client.Reader, lggr logger.Logger) *OCR2Reader {
 	return &OCR2Reader{
 		address:     addess,
+		bech32:      bech32,
 		chainReader: chainReader,
+		contract:    contracts.NewOCR2(addess, newBatchedQuerier(chainReader), contracts.WithBech32Prefix(string(bech32))),
 		lggr:        lggr,
 	}
 }
 
+// queryBatchWindow is how long contract queries wait for concurrent ones to be batched with,
+// like those of the config and transmission updates of ContractCache.
+const queryBatchWindow = 10 * time.Millisecond
+
+// batchedQuerier batches concurrent smart queries, and passes raw queries through.
+type batchedQuerier struct {
+	client.Reader
+	batcher *client.ContractStateBatcher
+}
+
+func newBatchedQuerier(reader client.Reader) *batchedQuerier {
+	return &batchedQuerier{
+		Reader:  reader,
+		batcher: client.NewContractStateBatcher(reader.BatchContractState, queryBatchWindow, client.DefaultMaxBatchSize),
+	}
+}
+
+func (q *batchedQuerier) ContractState(contractAddress cosmosSDK.AccAddress, queryMsg []byte) ([]byte, error) {
+	return q.batcher.ContractState(contractAddress, queryMsg)
+}
+
 func (r *OCR2Reader) LatestConfigDetails(ctx context.Context) (changedInBlock uint64, configDigest types.ConfigDigest, err error) {
-	resp, err := r.chainReader.ContractState(
-		r.address,
-		[]byte(`{"latest_config_details":{}}`),
-	)
-	if err != nil {
-		return
+	if r.verified != nil {
+		return r.verifiedLatestConfigDetails(ctx)
 	}
-	var config ConfigDetails
-	if err = json.Unmarshal(resp, &config); err != nil {
+	config, err := r.contract.LatestConfigDetails()
+	if err != nil {
 		return
 	}
 	changedInBlock = config.BlockNumber
@@ -53,31 +79,24 @@ func (r *OCR2Reader) LatestConfig(ctx context.Context, changedInBlock uint64) (t
 	// previously we queried with constraint "wasm-set_config._contract_address='address'" directly, but that does not
 	// work with wasmd 0.41.0, which is at cosmos-sdk v0.47.4, which contains the following regex for each event query string:
 	// https://github.com/cosmos/cosmos-sdk/blob/3b509c187e1643757f5ef8a0b5ae3decca0c7719/x/auth/tx/service.go#L49
-	query := []string{fmt.Sprintf("tx.height=%d", changedInBlock), fmt.Sprintf("wasm._contract_address='%s'", r.address)}
-	res, err := r.chainReader.TxsEvents(query, nil)
-	if err != nil {
-		return types.ContractConfig{}, err
-	}
-	if len(res.TxResponses) == 0 {
-		return types.ContractConfig{}, fmt.Errorf("No transactions found for block %d, query %v", changedInBlock, query)
-	}
-
-	// Use the first matching tx we find, since results are in descending order.
-	for _, txResponse := range res.TxResponses {
-		if len(txResponse.Logs) == 0 {
+	query := []string{fmt.Sprintf("tx.height=%d", changedInBlock), fmt.Sprintf("wasm._contract_address='%s'", r.bech32.Address(r.address))}
+	// Use the latest set_config event we find, since results are in descending order.
+	it := client.NewTxsEventsIterator(r.chainReader, query, txtypes.OrderBy_ORDER_BY_DESC, 0)
+	for it.Next() {
+		events := ContractEvents(it.TxResponse(), r.address, EventTypeSetConfig)
+		if len(events) == 0 {
 			continue
 		}
-		for _, event := range txResponse.Logs[0].Events {
-			if event.Type == "wasm-set_config" {
-				cc, unknown, err := parseAttributes(event.Attributes)
-				if len(unknown) > 0 {
-					r.lggr.Warnf("wasm-set_config event contained unrecognized attributes: %v", unknown)
-				}
-				return cc, err
-			}
+		event, unknown, err := ParseSetConfigEvent(events[len(events)-1])
+		if len(unknown) > 0 {
+			r.lggr.Warnf("wasm-set_config event contained unrecognized attributes: %v", unknown)
 		}
+		return event.ContractConfig, err
+	}
+	if err := it.Err(); err != nil {
+		return types.ContractConfig{}, err
 	}
-	return types.ContractConfig{}, fmt.Errorf("No set_config event found in block %d", changedInBlock)
+	return types.ContractConfig{}, fmt.Errorf("No set_config event found in block %d, query %v", changedInBlock, query)
 }
 
 // parseAttributes returns a ContractConfig parsed from attrs.
@@ -228,7 +247,10 @@ func (r *OCR2Reader) LatestTransmissionDetails(ctx context.Context) (
 	latestTimestamp time.Time,
 	err error,
 ) {
-	resp, err := r.chainReader.ContractState(r.address, []byte(`{"latest_transmission_details":{}}`))
+	if r.verified != nil {
+		return r.verifiedLatestTransmissionDetails(ctx)
+	}
+	details, err := r.contract.LatestTransmissionDetails()
 	if err != nil {
 		// Handle the 500 error that occurs when there has not been a submission
 		// "rpc error: code = Unknown desc = ocr2::state::Transmission not found: contract query failed: unknown request"
@@ -242,30 +264,18 @@ func (r *OCR2Reader) LatestTransmissionDetails(ctx context.Context) (
 			// transmission details to their zero value.
 			if err2 == nil {
 				if epoch != 0 {
-					r.lggr.Errorf("unexpected non-zero epoch %v and no transmissions found contract %v", epoch, r.address)
+					r.lggr.Errorf("unexpected non-zero epoch %v and no transmissions found contract %v", epoch, r.bech32.Address(r.address))
 				}
 				return digest, epoch, 0, big.NewInt(0), time.Unix(0, 0), nil
 			}
-			r.lggr.Errorf("error reading latest config digest and epoch err %v contract %v", err2, r.address)
+			r.lggr.Errorf("error reading latest config digest and epoch err %v contract %v", err2, r.bech32.Address(r.address))
 		}
 
 		// default response if there actually is an error
 		return types.ConfigDigest{}, 0, 0, big.NewInt(0), time.Now(), err
 	}
 
-	// unmarshal
-	var details LatestTransmissionDetails
-	if err := json.Unmarshal(resp, &details); err != nil {
-		return types.ConfigDigest{}, 0, 0, big.NewInt(0), time.Now(), err
-	}
-
-	// set answer big int
-	ans := new(big.Int)
-	if _, success := ans.SetString(details.LatestAnswer, 10); !success {
-		return types.ConfigDigest{}, 0, 0, big.NewInt(0), time.Now(), fmt.Errorf("Could not create *big.Int from %s", details.LatestAnswer)
-	}
-
-	return details.LatestConfigDigest, details.Epoch, details.Round, ans, time.Unix(details.LatestTimestamp, 0), nil
+	return details.LatestConfigDigest, details.Epoch, details.Round, details.LatestAnswer.Int, time.Unix(int64(details.LatestTimestamp), 0), nil
 }
 
 // LatestRoundRequested fetches the latest round requested by filtering event logs
@@ -337,15 +347,19 @@ func (r *OCR2Reader) LatestConfigDigestAndEpoch(ctx context.Context) (
 	epoch uint32,
 	err error,
 ) {
-	resp, err := r.chainReader.ContractState(
-		r.address, []byte(`{"latest_config_digest_and_epoch":{}}`),
-	)
-	if err != nil {
-		return types.ConfigDigest{}, 0, err
+	if r.verified != nil {
+		contract, err := r.verifiedContract(ctx)
+		if err != nil {
+			return types.ConfigDigest{}, 0, err
+		}
+		config, err := contract.Config()
+		if err != nil {
+			return types.ConfigDigest{}, 0, err
+		}
+		return config.LatestConfigDigest, config.Epoch, nil
 	}
-
-	var digest LatestConfigDigestAndEpoch
-	if err := json.Unmarshal(resp, &digest); err != nil {
+	digest, err := r.contract.LatestConfigDigestAndEpoch()
+	if err != nil {
 		return types.ConfigDigest{}, 0, err
 	}
 
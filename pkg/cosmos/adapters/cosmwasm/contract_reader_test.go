@@ -2,15 +2,31 @@ package cosmwasm
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
 	"strconv"
 	"testing"
+	"time"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cosmosSDK "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
-	cosmosSDK "github.com/cosmos/cosmos-sdk/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 	"github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/client"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/contracts"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/testutil/fakechain"
 )
 
 func Test_parseAttributes(t *testing.T) {
@@ -95,3 +111,149 @@ func mustStringToConfigDigest(t *testing.T, s string) types.ConfigDigest {
 	require.NoError(t, err)
 	return d
 }
+
+func TestOCR2Reader_fakechain(t *testing.T) {
+	chain := fakechain.New(fakechain.Config{})
+	key := secp256k1.GenPrivKey()
+	owner := cosmosSDK.AccAddress(key.PubKey().Address())
+	chain.Fund(owner, cosmosSDK.NewInt64Coin("ucosm", 1_000_000_000))
+
+	digest := mustStringToConfigDigest(t, "test config digest 32 chars long")
+	// the contract encodes digests as arrays, unlike ConfigDigest.MarshalJSON
+	digestJSON, err := json.Marshal([32]byte(digest))
+	require.NoError(t, err)
+	var blockNumber int64
+	contract := chain.Deploy(&fakechain.FuncContract{
+		QueryFunc: func(msg []byte) ([]byte, error) {
+			return []byte(fmt.Sprintf(`{"config_count":1,"block_number":%d,"config_digest":%s}`, blockNumber, digestJSON)), nil
+		},
+		ExecuteFunc: func(sender string, msg []byte, simulate bool) ([]abci.Event, error) {
+			return []abci.Event{
+				fakechain.WasmEvent("wasm", "action", "set_config"),
+				fakechain.WasmEvent("set_config",
+					"previous_config_block_number", "0",
+					"latest_config_digest", hex.EncodeToString(digest[:]),
+					"config_count", "1",
+					"signers", "0101010101010101010101010101010101010101010101010101010101010101",
+					"transmitters", sender,
+					"payees", sender,
+					"f", "1",
+					"onchain_config", "AQI=",
+					"offchain_config_version", "2",
+					"offchain_config", "AwQ=",
+				),
+			}, nil
+		},
+	})
+
+	number, sequence, err := chain.Account(owner)
+	require.NoError(t, err)
+	msg := &wasmtypes.MsgExecuteContract{Sender: owner.String(), Contract: contract.String(), Msg: []byte(`{"set_config":{}}`)}
+	gasPrice := cosmosSDK.NewDecCoinFromDec("ucosm", cosmosSDK.MustNewDecFromStr("0.01"))
+	_, err = chain.SignAndBroadcast([]cosmosSDK.Msg{msg}, number, sequence, gasPrice, key, txtypes.BroadcastMode_BROADCAST_MODE_SYNC)
+	require.NoError(t, err)
+	height := chain.NextBlock()
+	blockNumber = height
+
+	reader := NewOCR2Reader(contract, "wasm", chain, logger.Test(t))
+	changedInBlock, configDigest, err := reader.LatestConfigDetails(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(height), changedInBlock)
+	assert.Equal(t, digest, configDigest)
+
+	config, err := reader.LatestConfig(context.Background(), changedInBlock)
+	require.NoError(t, err)
+	assert.Equal(t, digest, config.ConfigDigest)
+	assert.Equal(t, []types.Account{types.Account(owner.String())}, config.Transmitters)
+	assert.Equal(t, uint8(1), config.F)
+}
+
+type fakeVerifiedState struct {
+	values  map[string][]byte
+	heights []int64
+}
+
+func (s *fakeVerifiedState) RawContractState(_ context.Context, _ cosmosSDK.AccAddress, key []byte, height int64) ([]byte, error) {
+	s.heights = append(s.heights, height)
+	return s.values[string(key)], nil
+}
+
+type fakeHeads struct{ height int64 }
+
+func (h fakeHeads) LatestHead(context.Context) (client.Head, error) {
+	return client.Head{Height: h.height}, nil
+}
+
+func TestOCR2Reader_VerifiedReads(t *testing.T) {
+	ctx := tests.Context(t)
+	address := cosmosSDK.AccAddress("contract____________")
+	digest := mustStringToConfigDigest(t, "test config digest 32 chars long")
+	mustJSON := func(v any) []byte {
+		b, err := json.Marshal(v)
+		require.NoError(t, err)
+		return b
+	}
+	// the contract stores the digest as an array of bytes, rather than the hex text of types.ConfigDigest
+	configJSON := func(config contracts.OCR2Config) []byte {
+		var fields map[string]any
+		require.NoError(t, json.Unmarshal(mustJSON(config), &fields))
+		digest := make([]int, len(config.LatestConfigDigest))
+		for i, b := range config.LatestConfigDigest {
+			digest[i] = int(b)
+		}
+		fields["latest_config_digest"] = digest
+		return mustJSON(fields)
+	}
+	state := &fakeVerifiedState{values: map[string][]byte{
+		string(contracts.ItemKey("config")): configJSON(contracts.OCR2Config{
+			ConfigCount:             2,
+			LatestConfigDigest:      digest,
+			LatestConfigBlockNumber: 5,
+			LatestAggregatorRoundID: 3,
+			Epoch:                   7,
+			Round:                   1,
+			MinAnswer:               contracts.NewInt(0),
+			MaxAnswer:               contracts.NewInt(0),
+		}),
+		string(contracts.MapKey("transmissions", contracts.Uint32Key(3))): mustJSON(contracts.Transmission{
+			Answer:                contracts.NewInt(-42),
+			TransmissionTimestamp: 1700000000,
+		}),
+	}}
+	// the node has no contract, so reads which are not verified fail
+	chain := fakechain.New(fakechain.Config{})
+	reader := NewVerifiedOCR2Reader(address, "wasm", chain, state, fakeHeads{height: 10}, logger.Test(t))
+
+	block, configDigest, err := reader.LatestConfigDetails(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), block)
+	assert.Equal(t, digest, configDigest)
+
+	configDigest, epoch, round, answer, timestamp, err := reader.LatestTransmissionDetails(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, digest, configDigest)
+	assert.Equal(t, uint32(7), epoch)
+	assert.Equal(t, uint8(1), round)
+	assert.Equal(t, big.NewInt(-42), answer)
+	assert.Equal(t, time.Unix(1700000000, 0), timestamp)
+
+	// state is verified as of the block before the latest head, whose header holds its app hash
+	for _, height := range state.heights {
+		assert.Equal(t, int64(9), height)
+	}
+
+	t.Run("no transmissions", func(t *testing.T) {
+		delete(state.values, string(contracts.MapKey("transmissions", contracts.Uint32Key(3))))
+		state.values[string(contracts.ItemKey("config"))] = configJSON(contracts.OCR2Config{
+			LatestConfigDigest: digest,
+			MinAnswer:          contracts.NewInt(0),
+			MaxAnswer:          contracts.NewInt(0),
+		})
+		configDigest, epoch, round, answer, _, err := reader.LatestTransmissionDetails(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, digest, configDigest)
+		assert.Zero(t, epoch)
+		assert.Zero(t, round)
+		assert.Equal(t, big.NewInt(0), answer)
+	})
+}
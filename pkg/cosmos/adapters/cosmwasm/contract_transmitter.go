@@ -2,15 +2,15 @@ package cosmwasm
 
 import (
 	"context"
-	"encoding/json"
 
-	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	cosmosSDK "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/adapters"
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/config"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/contracts"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
 
 	"github.com/smartcontractkit/libocr/offchainreporting2/chains/evmutil"
 	"github.com/smartcontractkit/libocr/offchainreporting2/types"
@@ -55,30 +55,25 @@ func (ct *ContractTransmitter) Transmit(
 	report types.Report,
 	sigs []types.AttributedOnchainSignature,
 ) error {
-	ct.lggr.Infof("[%s] Sending TX to %s", ct.jobID, ct.contract.String())
-	msgStruct := TransmitMsg{}
-	reportContext := evmutil.RawReportContext(reportCtx)
-	for _, r := range reportContext {
-		msgStruct.Transmit.ReportContext = append(msgStruct.Transmit.ReportContext, r[:]...)
+	bech32 := params.Bech32Prefix(ct.cfg.Bech32Prefix())
+	contract := bech32.Address(ct.contract)
+	ct.lggr.Infof("[%s] Sending TX to %s", ct.jobID, contract)
+	var reportContext []byte
+	for _, r := range evmutil.RawReportContext(reportCtx) {
+		reportContext = append(reportContext, r[:]...)
 	}
-	msgStruct.Transmit.Report = []byte(report)
+	var signatures [][]byte
 	for _, sig := range sigs {
-		msgStruct.Transmit.Signatures = append(msgStruct.Transmit.Signatures, sig.Signature)
+		signatures = append(signatures, sig.Signature)
 	}
-	msgBytes, err := json.Marshal(msgStruct)
+	m, err := contracts.NewOCR2(ct.contract, ct.chainReader, contracts.WithBech32Prefix(string(bech32))).TransmitMsg(ct.sender, reportContext, report, signatures)
 	if err != nil {
 		return err
 	}
-	m := &wasmtypes.MsgExecuteContract{
-		Sender:   ct.sender.String(),
-		Contract: ct.contract.String(),
-		Msg:      msgBytes,
-		Funds:    cosmosSDK.Coins{},
-	}
-	_, err = ct.msgEnqueuer.Enqueue(ctx, ct.contract.String(), m)
+	_, err = ct.msgEnqueuer.Enqueue(ctx, contract, m)
 	return err
 }
 
 func (ct *ContractTransmitter) FromAccount() (types.Account, error) {
-	return types.Account(ct.sender.String()), nil
+	return types.Account(params.Bech32Prefix(ct.cfg.Bech32Prefix()).Address(ct.sender)), nil
 }
@@ -0,0 +1,148 @@
+package cosmwasm
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	cosmosSDK "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/client"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
+)
+
+// Event types emitted by the ocr2 contract. wasmd prefixes custom event types with "wasm-".
+const (
+	EventTypeSetConfig       = "wasm-set_config"
+	EventTypeNewTransmission = "wasm-new_transmission"
+)
+
+// attrContractAddress is added by wasmd to every event emitted by a contract.
+const attrContractAddress = "_contract_address"
+
+// attrMsgIndex is added by SDK v0.50 to every event emitted while executing a msg.
+const attrMsgIndex = "msg_index"
+
+// ContractEvents returns the events of type eventType emitted by contract in tx, in emission order.
+func ContractEvents(tx *cosmosSDK.TxResponse, contract cosmosSDK.AccAddress, eventType string) []cosmosSDK.StringEvent {
+	var matching []cosmosSDK.StringEvent
+	for _, event := range client.TxEvents(tx) {
+		if event.Type != eventType {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == attrContractAddress && params.SameAddress(attr.Value, contract) {
+				matching = append(matching, event)
+				break
+			}
+		}
+	}
+	return matching
+}
+
+// SetConfigEvent is a parsed wasm-set_config event.
+type SetConfigEvent struct {
+	types.ContractConfig
+	PreviousConfigBlockNumber uint64
+	Payees                    []string
+}
+
+// ParseSetConfigEvent returns the SetConfigEvent parsed from event.
+// unknownKeys contains counts of any unrecognized keys, which are otherwise ignored.
+func ParseSetConfigEvent(event cosmosSDK.StringEvent) (output SetConfigEvent, unknownKeys map[string]int, err error) {
+	configAttrs := make([]cosmosSDK.Attribute, 0, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		switch attr.Key {
+		case attrContractAddress, attrMsgIndex:
+		case "previous_config_block_number":
+			output.PreviousConfigBlockNumber, err = strconv.ParseUint(attr.Value, 10, 64)
+			if err != nil {
+				err = &ErrAttrInvalid{Err: err, Key: attr.Key}
+				return
+			}
+		case "payees":
+			output.Payees = append(output.Payees, attr.Value)
+		default:
+			configAttrs = append(configAttrs, attr)
+		}
+	}
+	output.ContractConfig, unknownKeys, err = parseAttributes(configAttrs)
+	return
+}
+
+// NewTransmissionEvent is a parsed wasm-new_transmission event.
+type NewTransmissionEvent struct {
+	AggregatorRoundID     uint32
+	Answer                *big.Int
+	Transmitter           types.Account
+	ObservationsTimestamp time.Time
+	Observers             []byte
+	Observations          []*big.Int
+	JuelsPerFeeCoin       *big.Int
+	ConfigDigest          types.ConfigDigest
+	Epoch                 uint32
+	Round                 uint8
+	Reimbursement         *big.Int
+}
+
+// ParseNewTransmissionEvent returns the NewTransmissionEvent parsed from event.
+// An error is returned if any of the required attributes are missing or invalid.
+func ParseNewTransmissionEvent(event cosmosSDK.StringEvent) (output NewTransmissionEvent, err error) {
+	parseBig := func(s string) (*big.Int, error) {
+		i, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid integer %q", s)
+		}
+		return i, nil
+	}
+	known := make(map[string]struct{})
+	for _, attr := range event.Attributes {
+		key, value := attr.Key, attr.Value
+		known[key] = struct{}{}
+		var u uint64
+		switch key {
+		case "aggregator_round_id":
+			u, err = strconv.ParseUint(value, 10, 32)
+			output.AggregatorRoundID = uint32(u)
+		case "answer":
+			output.Answer, err = parseBig(value)
+		case "transmitter":
+			output.Transmitter = types.Account(value)
+		case "observations_timestamp":
+			u, err = strconv.ParseUint(value, 10, 32)
+			output.ObservationsTimestamp = time.Unix(int64(u), 0)
+		case "observers":
+			err = HexToByteArray(value, &output.Observers)
+		case "observations":
+			var o *big.Int
+			o, err = parseBig(value)
+			output.Observations = append(output.Observations, o)
+		case "juels_per_fee_coin":
+			output.JuelsPerFeeCoin, err = parseBig(value)
+		case "config_digest":
+			err = HexToConfigDigest(value, &output.ConfigDigest)
+		case "epoch":
+			u, err = strconv.ParseUint(value, 10, 32)
+			output.Epoch = uint32(u)
+		case "round":
+			u, err = strconv.ParseUint(value, 10, 8)
+			output.Round = uint8(u)
+		case "reimbursement":
+			output.Reimbursement, err = parseBig(value)
+		}
+		if err != nil {
+			err = &ErrAttrInvalid{Err: err, Key: key}
+			return
+		}
+	}
+	for _, key := range []string{"aggregator_round_id", "answer", "transmitter", "observations_timestamp", "config_digest", "epoch", "round"} {
+		if _, ok := known[key]; !ok {
+			err = fmt.Errorf("missing attribute %q", key)
+			return
+		}
+	}
+	return
+}
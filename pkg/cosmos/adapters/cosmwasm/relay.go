@@ -3,8 +3,10 @@ package cosmwasm
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
 	cosmosSDK "github.com/cosmos/cosmos-sdk/types"
+	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	relaytypes "github.com/smartcontractkit/chainlink-common/pkg/types"
@@ -13,17 +15,31 @@ import (
 	"github.com/smartcontractkit/libocr/offchainreporting2/types"
 
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/adapters"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/client"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/config"
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
 )
 
 var _ relaytypes.ConfigProvider = &configProvider{}
 
+func init() {
+	family := adapters.Family{
+		NewConfigProvider: func(ctx context.Context, lggr logger.Logger, chain adapters.Chain, args relaytypes.RelayArgs) (relaytypes.ConfigProvider, error) {
+			return NewConfigProvider(ctx, lggr, chain, args)
+		},
+		NewMedianProvider: NewMedianProvider,
+	}
+	// Injective runs CosmWasm contracts too, next to its native OCR module.
+	adapters.Register(config.ChainFamilyCosmWasm, config.ContractKindCosmWasm, family)
+	adapters.Register(config.ChainFamilyInjective, config.ContractKindCosmWasm, family)
+}
+
 type configProvider struct {
 	utils.StartStopOnce
 	digester types.OffchainConfigDigester
 	lggr     logger.Logger
 
-	tracker types.ContractConfigTracker
+	tracker *ContractTracker
 
 	chain         adapters.Chain
 	contractCache *ContractCache
@@ -37,7 +53,8 @@ func NewConfigProvider(ctx context.Context, lggr logger.Logger, chain adapters.C
 	if err != nil {
 		return nil, err
 	}
-	contractAddr, err := cosmosSDK.AccAddressFromBech32(args.ContractID)
+	bech32 := params.Bech32Prefix(chain.Config().Bech32Prefix())
+	contractAddr, err := bech32.Parse(args.ContractID)
 	if err != nil {
 		return nil, err
 	}
@@ -46,10 +63,18 @@ func NewConfigProvider(ctx context.Context, lggr logger.Logger, chain adapters.C
 	if err != nil {
 		return nil, err
 	}
-	reader := NewOCR2Reader(contractAddr, chainReader, lggr)
+	reader := NewOCR2Reader(contractAddr, bech32, chainReader, lggr)
+	if chain.Config().LightClientVerifiedReads() {
+		verifier := chain.LightBlockVerifier()
+		if verifier == nil {
+			return nil, errors.New("LightClientVerifiedReads requires the light client, enabled by LightClientTrustedHeight")
+		}
+		state := client.NewVerifiedStateReader(chainReader.Context().Client, verifier)
+		reader = NewVerifiedOCR2Reader(contractAddr, bech32, chainReader, state, chain.HeadTracker(), lggr)
+	}
 	contract := NewContractCache(chain.Config(), reader, lggr)
-	tracker := NewContractTracker(chainReader, contract)
-	digester := NewOffchainConfigDigester(relayConfig.ChainID, contractAddr)
+	tracker := NewContractTracker(chainReader, chain.HeadTracker(), contract, contractAddr, bech32, lggr)
+	digester := NewOffchainConfigDigester(relayConfig.ChainID, contractAddr, bech32)
 	return &configProvider{
 		digester:      digester,
 		tracker:       tracker,
@@ -69,14 +94,17 @@ func (c *configProvider) Name() string {
 func (c *configProvider) Start(context.Context) error {
 	return c.StartOnce("CosmosRelay", func() error {
 		c.lggr.Debugf("Starting")
-		return c.contractCache.Start()
+		if err := c.contractCache.Start(); err != nil {
+			return err
+		}
+		return c.tracker.Start()
 	})
 }
 
 func (c *configProvider) Close() error {
 	return c.StopOnce("CosmosRelay", func() error {
 		c.lggr.Debugf("Stopping")
-		return c.contractCache.Close()
+		return multierr.Combine(c.tracker.Close(), c.contractCache.Close())
 	})
 }
 
@@ -104,11 +132,12 @@ func NewMedianProvider(ctx context.Context, lggr logger.Logger, chain adapters.C
 	if err != nil {
 		return nil, err
 	}
-	bech32Addr, err := params.CreateBech32Address(pargs.TransmitterID, configProvider.chain.Config().Bech32Prefix())
+	bech32 := configProvider.chain.Config().Bech32Prefix()
+	bech32Addr, err := params.CreateBech32Address(pargs.TransmitterID, bech32)
 	if err != nil {
 		return nil, err
 	}
-	senderAddr, err := cosmosSDK.AccAddressFromBech32(bech32Addr)
+	senderAddr, err := params.Bech32Prefix(bech32).Parse(bech32Addr)
 	if err != nil {
 		return nil, err
 	}
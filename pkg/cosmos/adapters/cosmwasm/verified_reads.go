@@ -0,0 +1,101 @@
+package cosmwasm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	cosmosSDK "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/client"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/contracts"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
+)
+
+// VerifiedState reads raw contract state verified by a light client, e.g. a client.VerifiedStateReader.
+type VerifiedState interface {
+	RawContractState(ctx context.Context, contract cosmosSDK.AccAddress, key []byte, height int64) ([]byte, error)
+}
+
+var _ VerifiedState = (*client.VerifiedStateReader)(nil)
+
+// NewVerifiedOCR2Reader returns an OCR2Reader which reads the config and transmission details from the raw state of
+// the contract, as verified by state at the block before the latest head of heads, rather than trusting the node.
+// The contents of the config are still read from set_config events, which OCR checks against the verified digest.
+func NewVerifiedOCR2Reader(address cosmosSDK.AccAddress, bech32 params.Bech32Prefix, chainReader client.Reader, state VerifiedState, heads client.HeadReader, lggr logger.Logger) *OCR2Reader {
+	r := NewOCR2Reader(address, bech32, chainReader, lggr)
+	r.verified, r.heads = state, heads
+	return r
+}
+
+// verifiedContract returns a client of the contract whose raw reads are verified as of the latest verifiable block.
+func (r *OCR2Reader) verifiedContract(ctx context.Context) (*contracts.OCR2, error) {
+	head, err := r.heads.LatestHead(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest head: %w", err)
+	}
+	// the app hash of a block is in the header of the next one, so the latest head only proves the state before it
+	querier := verifiedQuerier{ctx: ctx, state: r.verified, height: head.Height - 1}
+	return contracts.NewOCR2(r.address, querier, contracts.WithBech32Prefix(string(r.bech32))), nil
+}
+
+func (r *OCR2Reader) verifiedLatestConfigDetails(ctx context.Context) (changedInBlock uint64, configDigest types.ConfigDigest, err error) {
+	contract, err := r.verifiedContract(ctx)
+	if err != nil {
+		return
+	}
+	config, err := contract.Config()
+	if err != nil {
+		return
+	}
+	return config.LatestConfigBlockNumber, config.LatestConfigDigest, nil
+}
+
+func (r *OCR2Reader) verifiedLatestTransmissionDetails(ctx context.Context) (
+	configDigest types.ConfigDigest,
+	epoch uint32,
+	round uint8,
+	latestAnswer *big.Int,
+	latestTimestamp time.Time,
+	err error,
+) {
+	contract, err := r.verifiedContract(ctx)
+	if err != nil {
+		return types.ConfigDigest{}, 0, 0, big.NewInt(0), time.Now(), err
+	}
+	config, err := contract.Config()
+	if err != nil {
+		return types.ConfigDigest{}, 0, 0, big.NewInt(0), time.Now(), err
+	}
+	if config.LatestAggregatorRoundID == 0 {
+		// no transmissions yet, like the fallback to latest_config_digest_and_epoch
+		return config.LatestConfigDigest, config.Epoch, 0, big.NewInt(0), time.Unix(0, 0), nil
+	}
+	transmission, err := contract.Transmission(config.LatestAggregatorRoundID)
+	if err != nil {
+		return types.ConfigDigest{}, 0, 0, big.NewInt(0), time.Now(), err
+	}
+	return config.LatestConfigDigest, config.Epoch, config.Round, transmission.Answer.Int, time.Unix(int64(transmission.TransmissionTimestamp), 0), nil
+}
+
+// verifiedQuerier reads raw contract state at height from state. Smart queries cannot be verified, so they fail.
+type verifiedQuerier struct {
+	ctx    context.Context
+	state  VerifiedState
+	height int64
+}
+
+var errSmartQueryUnverifiable = errors.New("smart queries cannot be verified")
+
+func (q verifiedQuerier) ContractState(cosmosSDK.AccAddress, []byte) ([]byte, error) {
+	return nil, errSmartQueryUnverifiable
+}
+
+func (q verifiedQuerier) RawContractState(contract cosmosSDK.AccAddress, key []byte) ([]byte, error) {
+	return q.state.RawContractState(q.ctx, contract, key, q.height)
+}
@@ -2,30 +2,85 @@ package injective
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	cmttypes "github.com/cometbft/cometbft/types"
 
-	tmtypes "github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/libocr/offchainreporting2/types"
 
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/adapters"
 	chaintypes "github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/adapters/injective/types"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/client"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
 )
 
+// eventConfigSet is the type of the typed event emitted by the ocr module on SetConfig.
+const eventConfigSet = "injective.ocr.v1beta1.EventConfigSet"
+
 var _ types.ContractConfigTracker = &CosmosModuleConfigTracker{}
 
 type CosmosModuleConfigTracker struct {
-	feedId                  string
-	injectiveClient         chaintypes.QueryClient
-	tendermintServiceClient tmtypes.ServiceClient
+	feedId          string
+	injectiveClient chaintypes.QueryClient
+	heads           client.HeadReader
+	bech32          params.Bech32Prefix
+	notifier        *adapters.ConfigNotifier
+	lggr            logger.Logger
+}
+
+// NewCosmosModuleConfigTracker returns a tracker of the config of feedId, whose signers and transmitters are
+// parsed with bech32, the prefix of the chain.
+func NewCosmosModuleConfigTracker(feedId string, queryClient chaintypes.QueryClient, heads client.HeadReader, subscriber client.EventSubscriber, bech32 params.Bech32Prefix, lggr logger.Logger) *CosmosModuleConfigTracker {
+	c := &CosmosModuleConfigTracker{
+		feedId:          feedId,
+		injectiveClient: queryClient,
+		heads:           heads,
+		bech32:          bech32,
+		lggr:            lggr,
+	}
+	// The feed ID is nested in the JSON encoded config attribute, so we filter by it client side.
+	query := fmt.Sprintf("%s.config_digest EXISTS", eventConfigSet)
+	c.notifier = adapters.NewConfigNotifier(subscriber, query, c.isFeedConfigSet, lggr)
+	return c
+}
+
+// Start subscribes to EventConfigSet events.
+func (c *CosmosModuleConfigTracker) Start() error {
+	return c.notifier.Start()
+}
+
+func (c *CosmosModuleConfigTracker) Close() error {
+	return c.notifier.Close()
 }
 
-func NewCosmosModuleConfigTracker(feedId string, queryClient chaintypes.QueryClient, serviceClient tmtypes.ServiceClient) *CosmosModuleConfigTracker {
-	return &CosmosModuleConfigTracker{
-		feedId:                  feedId,
-		injectiveClient:         queryClient,
-		tendermintServiceClient: serviceClient,
+// isFeedConfigSet returns true if tx emitted an EventConfigSet for our feed.
+func (c *CosmosModuleConfigTracker) isFeedConfigSet(_ context.Context, tx cmttypes.EventDataTx) bool {
+	for _, event := range tx.Result.Events {
+		if event.Type != eventConfigSet {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key != "config" {
+				continue
+			}
+			// typed event attribute values are the JSON encoded fields
+			var config struct {
+				ModuleParams struct {
+					FeedID string `json:"feed_id"`
+				} `json:"module_params"`
+			}
+			if err := json.Unmarshal([]byte(attr.Value), &config); err != nil {
+				c.lggr.Warnw("Failed to decode EventConfigSet config", "err", err)
+				continue
+			}
+			if config.ModuleParams.FeedID == c.feedId {
+				return true
+			}
+		}
 	}
+	return false
 }
 
 // Notify may optionally emit notification events when the contract's
@@ -35,8 +90,7 @@ func NewCosmosModuleConfigTracker(feedId string, queryClient chaintypes.QueryCli
 //
 // The returned channel should never be closed.
 func (c *CosmosModuleConfigTracker) Notify() <-chan struct{} {
-	// TODO: track events from Tendermint WS
-	return nil
+	return c.notifier.Notify()
 }
 
 // LatestConfigDetails returns information about the latest configuration,
@@ -79,14 +133,20 @@ func (c *CosmosModuleConfigTracker) LatestConfig(
 
 	signers := make([]types.OnchainPublicKey, 0, len(resp.FeedConfig.Signers))
 	for _, addr := range resp.FeedConfig.Signers {
-		acc := sdk.MustAccAddressFromBech32(addr)
+		acc, err := c.bech32.Parse(addr)
+		if err != nil {
+			return types.ContractConfig{}, fmt.Errorf("invalid signer %q: %w", addr, err)
+		}
 		signers = append(signers, types.OnchainPublicKey(acc.Bytes()))
 	}
 
 	transmitters := make([]types.Account, 0, len(resp.FeedConfig.Transmitters))
 	for _, addr := range resp.FeedConfig.Transmitters {
-		acc := sdk.MustAccAddressFromBech32(addr)
-		transmitters = append(transmitters, types.Account(acc.String()))
+		acc, err := c.bech32.Parse(addr)
+		if err != nil {
+			return types.ContractConfig{}, fmt.Errorf("invalid transmitter %q: %w", addr, err)
+		}
+		transmitters = append(transmitters, types.Account(c.bech32.Address(acc)))
 	}
 
 	config := types.ContractConfig{
@@ -103,7 +163,6 @@ func (c *CosmosModuleConfigTracker) LatestConfig(
 	return config, nil
 }
 
-// TODO: duplicated from wasm adapter
 // LatestBlockHeight returns the height of the most recent block in the chain.
 func (c *CosmosModuleConfigTracker) LatestBlockHeight(
 	ctx context.Context,
@@ -111,9 +170,9 @@ func (c *CosmosModuleConfigTracker) LatestBlockHeight(
 	blockHeight uint64,
 	err error,
 ) {
-	b, err := c.tendermintServiceClient.GetLatestBlock(context.Background(), &tmtypes.GetLatestBlockRequest{})
+	head, err := c.heads.LatestHead(ctx)
 	if err != nil {
 		return 0, err
 	}
-	return uint64(b.SdkBlock.Header.Height), nil
+	return uint64(head.Height), nil
 }
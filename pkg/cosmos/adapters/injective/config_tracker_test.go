@@ -0,0 +1,87 @@
+package injective
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+	"github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/adapters/cosmwasm"
+	chaintypes "github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/adapters/injective/types"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
+)
+
+// TestCosmosModuleConfigTracker_Bech32Prefix serves an injective feed while the global sdk.Config is that of a wasm chain.
+func TestCosmosModuleConfigTracker_Bech32Prefix(t *testing.T) {
+	const inj params.Bech32Prefix = "inj"
+	var signers, transmitters []string
+	for _, name := range []string{"a", "b", "c", "d"} {
+		signers = append(signers, inj.Address(sdk.AccAddress("signer_"+name+"____________")))
+		transmitters = append(transmitters, inj.Address(sdk.AccAddress("transmitter_"+name+"_______")))
+	}
+	feedConfig := &chaintypes.FeedConfig{
+		Signers:               signers,
+		Transmitters:          transmitters,
+		F:                     1,
+		OnchainConfig:         []byte{0x01},
+		OffchainConfigVersion: 2,
+		OffchainConfig:        []byte{0x03},
+		ModuleParams: &chaintypes.ModuleParams{
+			FeedId:              "feed",
+			MinAnswer:           sdk.NewDec(1),
+			MaxAnswer:           sdk.NewDec(100),
+			LinkPerObservation:  sdk.NewInt(1),
+			LinkPerTransmission: sdk.NewInt(1),
+			LinkDenom:           "peggy0x514910771AF9Ca656af840dff83E8264EcF986CA",
+			FeedAdmin:           signers[0],
+			BillingAdmin:        signers[0],
+		},
+	}
+	require.NoError(t, feedConfig.ValidateBasic())
+	onchainDigest := (&chaintypes.ContractConfig{
+		ConfigCount:           3,
+		Signers:               feedConfig.Signers,
+		Transmitters:          feedConfig.Transmitters,
+		F:                     feedConfig.F,
+		OnchainConfig:         feedConfig.OnchainConfig,
+		OffchainConfigVersion: feedConfig.OffchainConfigVersion,
+		OffchainConfig:        feedConfig.OffchainConfig,
+	}).Digest("injective-1", "feed")
+
+	m := &fakeOCRModule{
+		feedConfig: &chaintypes.QueryFeedConfigResponse{
+			FeedConfigInfo: &chaintypes.FeedConfigInfo{LatestConfigDigest: onchainDigest, ConfigCount: 3},
+			FeedConfig:     feedConfig,
+		},
+	}
+	tracker := NewCosmosModuleConfigTracker("feed", chaintypes.NewQueryClient(newOCRModuleConn(t, m)), nil, nil, inj, logger.Test(t))
+	cfg, err := tracker.LatestConfig(tests.Context(t), 1)
+	require.NoError(t, err)
+	for i, transmitter := range transmitters {
+		assert.Equal(t, types.Account(transmitter), cfg.Transmitters[i])
+	}
+	assert.Equal(t, types.OnchainPublicKey(sdk.AccAddress("signer_a____________")), cfg.Signers[0])
+
+	// the digest matches that of the module, which encodes addresses with the prefix of its own chain
+	digest, err := NewCosmosOffchainConfigDigester("injective-1", "feed", inj).ConfigDigest(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, configDigestFromBytes(onchainDigest), digest)
+
+	transmit := chaintypes.MsgTransmit{Transmitter: transmitters[0]}
+	assert.Equal(t, []sdk.AccAddress{sdk.AccAddress("transmitter_a_______")}, transmit.GetSigners())
+
+	// a wasm chain is served side by side
+	wasmContract := sdk.AccAddress("contract____________")
+	wasmCfg := cfg
+	wasmCfg.Transmitters = []types.Account{types.Account(params.Bech32Prefix("wasm").Address(sdk.AccAddress("transmitter_a_______")))}
+	_, err = cosmwasm.NewOffchainConfigDigester("wasm-1", wasmContract, "wasm").ConfigDigest(wasmCfg)
+	require.NoError(t, err)
+	// addresses of the other chain are rejected
+	_, err = NewCosmosOffchainConfigDigester("injective-1", "feed", inj).ConfigDigest(wasmCfg)
+	require.Error(t, err)
+}
@@ -0,0 +1,19 @@
+package injective
+
+import (
+	"os"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
+)
+
+// TestMain configures the global sdk.Config for a wasm chain, like a node which started a wasm chain first,
+// so tests check that injective chains do not depend on it.
+func TestMain(m *testing.M) {
+	params.InitCosmosSdk(
+		/* bech32Prefix= */ "wasm",
+		/* token= */ "cosm",
+	)
+	code := m.Run()
+	os.Exit(code)
+}
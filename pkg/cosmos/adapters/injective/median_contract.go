@@ -56,7 +56,8 @@ func (c *CosmosMedianReporter) LatestTransmissionDetails(
 	}
 
 	if resp.Data != nil {
-		latestAnswer = resp.Data.Answer.BigInt()
+		// answers are integers, see median_report.ReportCodec
+		latestAnswer = resp.Data.Answer.TruncateInt().BigInt()
 		latestTimestamp = time.Unix(resp.Data.TransmissionTimestamp, 0)
 	} else {
 		latestAnswer = big.NewInt(0)
@@ -67,6 +68,8 @@ func (c *CosmosMedianReporter) LatestTransmissionDetails(
 	return
 }
 
+// LatestRoundRequested returns zero values, since the ocr module has no msg to request a new round.
+// Rounds are only started by transmissions, see EventNewRound.
 func (c *CosmosMedianReporter) LatestRoundRequested(
 	ctx context.Context,
 	lookback time.Duration,
@@ -76,6 +79,5 @@ func (c *CosmosMedianReporter) LatestRoundRequested(
 	round uint8,
 	err error,
 ) {
-	// TODO:
-	return
+	return types.ConfigDigest{}, 0, 0, nil
 }
@@ -0,0 +1,141 @@
+package injective
+
+import (
+	"context"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	chaintypes "github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/adapters/injective/types"
+)
+
+// fakeOCRModule serves the queries and msgs of the ocr module for a single feed.
+type fakeOCRModule struct {
+	chaintypes.UnimplementedQueryServer
+	chaintypes.UnimplementedMsgServer
+
+	feedConfig          *chaintypes.QueryFeedConfigResponse
+	feedConfigInfo      *chaintypes.QueryFeedConfigInfoResponse
+	transmissionDetails *chaintypes.QueryLatestTransmissionDetailsResponse
+	transmitted         []*chaintypes.MsgTransmit
+	requestedFeedIDs    []string
+}
+
+func (m *fakeOCRModule) FeedConfig(_ context.Context, req *chaintypes.QueryFeedConfigRequest) (*chaintypes.QueryFeedConfigResponse, error) {
+	m.requestedFeedIDs = append(m.requestedFeedIDs, req.FeedId)
+	return m.feedConfig, nil
+}
+
+func (m *fakeOCRModule) FeedConfigInfo(_ context.Context, req *chaintypes.QueryFeedConfigInfoRequest) (*chaintypes.QueryFeedConfigInfoResponse, error) {
+	m.requestedFeedIDs = append(m.requestedFeedIDs, req.FeedId)
+	return m.feedConfigInfo, nil
+}
+
+func (m *fakeOCRModule) LatestTransmissionDetails(_ context.Context, req *chaintypes.QueryLatestTransmissionDetailsRequest) (*chaintypes.QueryLatestTransmissionDetailsResponse, error) {
+	m.requestedFeedIDs = append(m.requestedFeedIDs, req.FeedId)
+	return m.transmissionDetails, nil
+}
+
+func (m *fakeOCRModule) Transmit(_ context.Context, msg *chaintypes.MsgTransmit) (*chaintypes.MsgTransmitResponse, error) {
+	m.transmitted = append(m.transmitted, msg)
+	return &chaintypes.MsgTransmitResponse{}, nil
+}
+
+// newOCRModuleConn serves m over an in-memory connection, with the generated services and the SDK codec.
+func newOCRModuleConn(t *testing.T, m *fakeOCRModule) *grpc.ClientConn {
+	grpcCodec := codec.NewProtoCodec(codectypes.NewInterfaceRegistry()).GRPCCodec()
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(grpc.ForceServerCodec(grpcCodec))
+	chaintypes.RegisterQueryServer(srv, m)
+	chaintypes.RegisterMsgServer(srv, m)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(grpcCodec)),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func testDigest(b byte) types.ConfigDigest {
+	var digest types.ConfigDigest
+	digest[1] = byte(ConfigDigestPrefixCosmos)
+	digest[31] = b
+	return digest
+}
+
+func TestCosmosMedianReporter_LatestTransmissionDetails(t *testing.T) {
+	ctx := context.Background()
+	digest := testDigest(1)
+
+	t.Run("transmitted", func(t *testing.T) {
+		m := &fakeOCRModule{transmissionDetails: &chaintypes.QueryLatestTransmissionDetailsResponse{
+			ConfigDigest:  digest[:],
+			EpochAndRound: &chaintypes.EpochAndRound{Epoch: 7, Round: 3},
+			Data: &chaintypes.Transmission{
+				Answer:                sdk.NewDec(1234),
+				ObservationsTimestamp: 100,
+				TransmissionTimestamp: 200,
+			},
+		}}
+		reporter := NewCosmosMedianReporter("feed", chaintypes.NewQueryClient(newOCRModuleConn(t, m)))
+
+		gotDigest, epoch, round, answer, ts, err := reporter.LatestTransmissionDetails(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, digest, gotDigest)
+		assert.Equal(t, uint32(7), epoch)
+		assert.Equal(t, uint8(3), round)
+		// the answer is the integer median of the report, like MedianFromReport
+		assert.Equal(t, big.NewInt(1234), answer)
+		assert.Equal(t, time.Unix(200, 0), ts)
+		assert.Equal(t, []string{"feed"}, m.requestedFeedIDs)
+	})
+
+	t.Run("not transmitted", func(t *testing.T) {
+		m := &fakeOCRModule{transmissionDetails: &chaintypes.QueryLatestTransmissionDetailsResponse{
+			ConfigDigest: digest[:],
+		}}
+		reporter := NewCosmosMedianReporter("feed", chaintypes.NewQueryClient(newOCRModuleConn(t, m)))
+
+		_, epoch, round, answer, ts, err := reporter.LatestTransmissionDetails(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, epoch)
+		assert.Zero(t, round)
+		assert.Equal(t, big.NewInt(0), answer)
+		assert.True(t, ts.IsZero())
+	})
+
+	t.Run("no config", func(t *testing.T) {
+		m := &fakeOCRModule{transmissionDetails: &chaintypes.QueryLatestTransmissionDetailsResponse{}}
+		reporter := NewCosmosMedianReporter("feed", chaintypes.NewQueryClient(newOCRModuleConn(t, m)))
+
+		_, _, _, _, _, err := reporter.LatestTransmissionDetails(ctx)
+		require.ErrorContains(t, err, "unable to receive config digest")
+	})
+}
+
+func TestCosmosMedianReporter_LatestRoundRequested(t *testing.T) {
+	reporter := NewCosmosMedianReporter("feed", chaintypes.NewQueryClient(newOCRModuleConn(t, &fakeOCRModule{})))
+
+	digest, epoch, round, err := reporter.LatestRoundRequested(context.Background(), time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, types.ConfigDigest{}, digest)
+	assert.Zero(t, epoch)
+	assert.Zero(t, round)
+}
@@ -60,10 +60,18 @@ func (ReportCodec) BuildReport(observations []median.ParsedAttributedObservation
 	return types.Report(reportBytes), err
 }
 
+// MaxReportLength returns the length of the encoding of a report with n observations of median.MinValue,
+// which has the longest decimal encoding, and the longest varint encoded timestamp.
 func (ReportCodec) MaxReportLength(n int) (int, error) {
-	// TODO:
-	return 0, nil
-	// return prefixSizeBytes + (n * observationSizeBytes) + juelsPerFeeCoinSizeBytes
+	report := &injectivetypes.Report{
+		ObservationsTimestamp: -1,
+		Observers:             make([]byte, n),
+		Observations:          make([]sdk.Dec, n),
+	}
+	for i := range report.Observations {
+		report.Observations[i] = sdk.NewDecFromBigInt(median.MinValue())
+	}
+	return report.Size(), nil
 }
 
 func (ReportCodec) MedianFromReport(report types.Report) (*big.Int, error) {
@@ -77,7 +85,8 @@ func (ReportCodec) MedianFromReport(report types.Report) (*big.Int, error) {
 		return nil, err
 	}
 
-	median := reportRaw.Observations[len(reportRaw.Observations)/2].BigInt()
+	// observations are integers, see BuildReport
+	median := reportRaw.Observations[len(reportRaw.Observations)/2].TruncateInt().BigInt()
 
 	return median, nil
 }
@@ -0,0 +1,59 @@
+package median_report
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+)
+
+func TestReportCodec(t *testing.T) {
+	codec := ReportCodec{}
+
+	t.Run("median", func(t *testing.T) {
+		report, err := codec.BuildReport([]median.ParsedAttributedObservation{
+			{Timestamp: 2, Value: big.NewInt(300), Observer: 0},
+			{Timestamp: 1, Value: big.NewInt(-100), Observer: 1},
+			{Timestamp: 3, Value: big.NewInt(200), Observer: 2},
+		})
+		require.NoError(t, err)
+
+		parsed, err := ParseReport(report)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), parsed.ObservationsTimestamp)
+		assert.Equal(t, []byte{1, 2, 0}, parsed.Observers)
+
+		// the median is the observed value, not its fixed point representation
+		m, err := codec.MedianFromReport(report)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(200), m)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		_, err := codec.BuildReport(nil)
+		require.Error(t, err)
+	})
+
+	t.Run("max length", func(t *testing.T) {
+		for _, n := range []int{1, 4, 31} {
+			maxLength, err := codec.MaxReportLength(n)
+			require.NoError(t, err)
+
+			observations := make([]median.ParsedAttributedObservation, n)
+			for i := range observations {
+				observations[i] = median.ParsedAttributedObservation{Timestamp: ^uint32(0), Value: median.MinValue(), Observer: 255}
+			}
+			report, err := codec.BuildReport(observations)
+			require.NoError(t, err)
+			assert.LessOrEqual(t, len(report), maxLength)
+
+			observations[0].Value = median.MaxValue()
+			report, err = codec.BuildReport(observations)
+			require.NoError(t, err)
+			assert.LessOrEqual(t, len(report), maxLength)
+		}
+	})
+}
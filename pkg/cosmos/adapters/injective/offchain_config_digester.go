@@ -5,6 +5,7 @@ import (
 	"github.com/smartcontractkit/libocr/offchainreporting2/types"
 
 	chaintypes "github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/adapters/injective/types"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
 )
 
 const ConfigDigestPrefixCosmos types.ConfigDigestPrefix = 2
@@ -14,29 +15,33 @@ var _ types.OffchainConfigDigester = CosmosOffchainConfigDigester{}
 type CosmosOffchainConfigDigester struct {
 	chainID string
 	feedID  string
+	bech32  params.Bech32Prefix
 }
 
-func NewCosmosOffchainConfigDigester(chainID string, feedID string) *CosmosOffchainConfigDigester {
+// NewCosmosOffchainConfigDigester returns a digester for feedID on chainID. Digests commit to the bech32 encoding
+// of the signers and transmitters, so bech32 must be the prefix of the chain for them to match those of the module.
+func NewCosmosOffchainConfigDigester(chainID string, feedID string, bech32 params.Bech32Prefix) *CosmosOffchainConfigDigester {
 	return &CosmosOffchainConfigDigester{
 		chainID: chainID,
 		feedID:  feedID,
+		bech32:  bech32,
 	}
 }
 
 func (d CosmosOffchainConfigDigester) ConfigDigest(cc types.ContractConfig) (types.ConfigDigest, error) {
 	signers := make([]string, 0, len(cc.Signers))
 	for _, acc := range cc.Signers {
-		signers = append(signers, sdk.AccAddress(acc).String())
+		signers = append(signers, d.bech32.Address(sdk.AccAddress(acc)))
 	}
 
 	transmitters := make([]string, 0, len(cc.Transmitters))
 	for _, acc := range cc.Transmitters {
-		addr, err := sdk.AccAddressFromBech32(string(acc))
+		addr, err := d.bech32.Parse(string(acc))
 		if err != nil {
 			return types.ConfigDigest{}, err
 		}
 
-		transmitters = append(transmitters, addr.String())
+		transmitters = append(transmitters, d.bech32.Address(addr))
 	}
 
 	chainContractConfig := &chaintypes.ContractConfig{
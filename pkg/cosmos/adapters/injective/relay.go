@@ -4,8 +4,6 @@ import (
 	"context"
 	"encoding/json"
 
-	tmtypes "github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
-	cosmosSDK "github.com/cosmos/cosmos-sdk/types"
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	relaytypes "github.com/smartcontractkit/chainlink-common/pkg/types"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils"
@@ -16,16 +14,27 @@ import (
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/adapters/injective/median_report"
 	injectivetypes "github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/adapters/injective/types"
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/client"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/config"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
 )
 
 var _ relaytypes.ConfigProvider = &configProvider{}
 
+func init() {
+	adapters.Register(config.ChainFamilyInjective, config.ContractKindModule, adapters.Family{
+		NewConfigProvider: func(ctx context.Context, lggr logger.Logger, chain adapters.Chain, args relaytypes.RelayArgs) (relaytypes.ConfigProvider, error) {
+			return NewConfigProvider(ctx, lggr, chain, args)
+		},
+		NewMedianProvider: NewMedianProvider,
+	})
+}
+
 type configProvider struct {
 	utils.StartStopOnce
 	digester types.OffchainConfigDigester
 	lggr     logger.Logger
 
-	tracker types.ContractConfigTracker
+	tracker *CosmosModuleConfigTracker
 
 	chain           adapters.Chain
 	reader          client.Reader
@@ -48,10 +57,10 @@ func NewConfigProvider(ctx context.Context, lggr logger.Logger, chain adapters.C
 	}
 	clientCtx := reader.Context()
 	injectiveClient := injectivetypes.NewQueryClient(clientCtx)
-	tendermintServiceClient := tmtypes.NewServiceClient(clientCtx)
 
-	tracker := NewCosmosModuleConfigTracker(feedID, injectiveClient, tendermintServiceClient)
-	digester := NewCosmosOffchainConfigDigester(relayConfig.ChainID, feedID)
+	bech32 := params.Bech32Prefix(chain.Config().Bech32Prefix())
+	tracker := NewCosmosModuleConfigTracker(feedID, injectiveClient, chain.HeadTracker(), reader, bech32, lggr)
+	digester := NewCosmosOffchainConfigDigester(relayConfig.ChainID, feedID, bech32)
 	return &configProvider{
 		// TODO:
 		digester:        digester,
@@ -71,14 +80,14 @@ func (c *configProvider) Name() string {
 func (c *configProvider) Start(context.Context) error {
 	return c.StartOnce("CosmosRelay", func() error {
 		c.lggr.Debugf("Starting")
-		return nil
+		return c.tracker.Start()
 	})
 }
 
 func (c *configProvider) Close() error {
 	return c.StopOnce("CosmosRelay", func() error {
 		c.lggr.Debugf("Stopping")
-		return nil
+		return c.tracker.Close()
 	})
 }
 
@@ -110,11 +119,17 @@ func NewMedianProvider(ctx context.Context, lggr logger.Logger, chain adapters.C
 	reportCodec := median_report.ReportCodec{}
 	injectiveClient := configProvider.injectiveClient
 	contract := NewCosmosMedianReporter(configProvider.feedID, injectiveClient)
-	senderAddr, err := cosmosSDK.AccAddressFromBech32(pargs.TransmitterID)
+	// like the keystore, transmitters are identified by their public key
+	bech32 := configProvider.chain.Config().Bech32Prefix()
+	bech32Addr, err := params.CreateBech32Address(pargs.TransmitterID, bech32)
+	if err != nil {
+		return nil, err
+	}
+	senderAddr, err := params.Bech32Prefix(bech32).Parse(bech32Addr)
 	if err != nil {
 		return nil, err
 	}
-	transmitter := NewCosmosModuleTransmitter(injectiveClient, configProvider.feedID, senderAddr, configProvider.chain.TxManager(), lggr)
+	transmitter := NewCosmosModuleTransmitter(injectiveClient, configProvider.feedID, senderAddr, params.Bech32Prefix(bech32), configProvider.chain.TxManager(), lggr)
 	return &medianProvider{
 		configProvider: configProvider,
 		reportCodec:    reportCodec,
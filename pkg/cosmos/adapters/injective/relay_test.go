@@ -0,0 +1,18 @@
+package injective
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/adapters"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/config"
+)
+
+func TestRegister(t *testing.T) {
+	family, err := adapters.LookupFamily(config.ChainFamilyInjective, config.ContractKindModule)
+	require.NoError(t, err)
+	assert.NotNil(t, family.NewConfigProvider)
+	assert.NotNil(t, family.NewMedianProvider)
+}
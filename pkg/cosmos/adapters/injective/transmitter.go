@@ -2,6 +2,7 @@ package injective
 
 import (
 	"context"
+	"fmt"
 
 	cosmosSDK "github.com/cosmos/cosmos-sdk/types"
 	"github.com/smartcontractkit/libocr/offchainreporting2/types"
@@ -11,6 +12,7 @@ import (
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/adapters"
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/adapters/injective/median_report"
 	chaintypes "github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/adapters/injective/types"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
 )
 
 var _ types.ContractTransmitter = &CosmosModuleTransmitter{}
@@ -21,12 +23,14 @@ type CosmosModuleTransmitter struct {
 	msgEnqueuer adapters.MsgEnqueuer
 	feedID      string
 	sender      cosmosSDK.AccAddress
+	bech32      params.Bech32Prefix
 }
 
 func NewCosmosModuleTransmitter(
 	queryClient chaintypes.QueryClient,
 	feedId string,
 	sender cosmosSDK.AccAddress,
+	bech32 params.Bech32Prefix,
 	msgEnqueuer adapters.MsgEnqueuer,
 	lggr logger.Logger,
 ) *CosmosModuleTransmitter {
@@ -36,11 +40,12 @@ func NewCosmosModuleTransmitter(
 		queryClient: queryClient,
 		msgEnqueuer: msgEnqueuer,
 		sender:      sender,
+		bech32:      bech32,
 	}
 }
 
 func (c *CosmosModuleTransmitter) FromAccount() (types.Account, error) {
-	return types.Account(c.sender.String()), nil
+	return types.Account(c.bech32.Address(c.sender)), nil
 }
 
 // Transmit sends the report to the on-chain OCR2Aggregator smart contract's Transmit method
@@ -58,7 +63,7 @@ func (c *CosmosModuleTransmitter) Transmit(
 	}
 
 	msgTransmit := &chaintypes.MsgTransmit{
-		Transmitter:  c.sender.String(),
+		Transmitter:  c.bech32.Address(c.sender),
 		ConfigDigest: reportCtx.ConfigDigest[:],
 		FeedId:       c.feedID,
 		Epoch:        uint64(reportCtx.Epoch),
@@ -90,7 +95,13 @@ func (c *CosmosModuleTransmitter) LatestConfigDigestAndEpoch(
 		return types.ConfigDigest{}, 0, err
 	}
 
+	if resp.FeedConfigInfo == nil {
+		return types.ConfigDigest{}, 0, fmt.Errorf("feed config not found: %s", c.feedID)
+	}
+
 	configDigest = configDigestFromBytes(resp.FeedConfigInfo.LatestConfigDigest)
-	epoch = uint32(resp.EpochAndRound.Epoch)
+	if resp.EpochAndRound != nil {
+		epoch = uint32(resp.EpochAndRound.Epoch)
+	}
 	return configDigest, epoch, nil
 }
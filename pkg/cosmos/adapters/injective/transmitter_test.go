@@ -0,0 +1,96 @@
+package injective
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	cosmosSDK "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	"github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/adapters/injective/median_report"
+	chaintypes "github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/adapters/injective/types"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
+)
+
+type fakeMsgEnqueuer struct {
+	contractIDs []string
+	msgs        []cosmosSDK.Msg
+}
+
+func (f *fakeMsgEnqueuer) Enqueue(_ context.Context, contractID string, msg cosmosSDK.Msg) (int64, error) {
+	f.contractIDs = append(f.contractIDs, contractID)
+	f.msgs = append(f.msgs, msg)
+	return int64(len(f.msgs)), nil
+}
+
+func TestCosmosModuleTransmitter(t *testing.T) {
+	ctx := context.Background()
+	digest := testDigest(2)
+	sender := cosmosSDK.AccAddress("transmitter_________")
+	senderAddr := params.Bech32Prefix("inj").Address(sender)
+
+	m := &fakeOCRModule{feedConfigInfo: &chaintypes.QueryFeedConfigInfoResponse{
+		FeedConfigInfo: &chaintypes.FeedConfigInfo{LatestConfigDigest: digest[:]},
+		EpochAndRound:  &chaintypes.EpochAndRound{Epoch: 5, Round: 1},
+	}}
+	conn := newOCRModuleConn(t, m)
+	enqueuer := &fakeMsgEnqueuer{}
+	transmitter := NewCosmosModuleTransmitter(chaintypes.NewQueryClient(conn), "feed", sender, "inj", enqueuer, logger.Test(t))
+
+	t.Run("from account", func(t *testing.T) {
+		account, err := transmitter.FromAccount()
+		require.NoError(t, err)
+		assert.Equal(t, types.Account(senderAddr), account)
+	})
+
+	t.Run("latest config digest and epoch", func(t *testing.T) {
+		gotDigest, epoch, err := transmitter.LatestConfigDigestAndEpoch(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, digest, gotDigest)
+		assert.Equal(t, uint32(5), epoch)
+	})
+
+	t.Run("transmit", func(t *testing.T) {
+		report, err := median_report.ReportCodec{}.BuildReport([]median.ParsedAttributedObservation{
+			{Timestamp: 10, Value: big.NewInt(3), Observer: 0},
+			{Timestamp: 12, Value: big.NewInt(1), Observer: 1},
+			{Timestamp: 11, Value: big.NewInt(2), Observer: 2},
+		})
+		require.NoError(t, err)
+		reportCtx := types.ReportContext{ReportTimestamp: types.ReportTimestamp{ConfigDigest: digest, Epoch: 5, Round: 2}}
+		sigs := []types.AttributedOnchainSignature{{Signature: []byte{0x01}, Signer: 0}, {Signature: []byte{0x02}, Signer: 1}}
+
+		require.NoError(t, transmitter.Transmit(ctx, reportCtx, report, sigs))
+		require.Len(t, enqueuer.msgs, 1)
+		assert.Equal(t, []string{"feed"}, enqueuer.contractIDs)
+		msg, ok := enqueuer.msgs[0].(*chaintypes.MsgTransmit)
+		require.True(t, ok, "unexpected msg %T", enqueuer.msgs[0])
+		require.NoError(t, msg.ValidateBasic())
+		assert.Equal(t, senderAddr, msg.Transmitter)
+		assert.Equal(t, "feed", msg.FeedId)
+		assert.Equal(t, digest[:], msg.ConfigDigest)
+		assert.Equal(t, uint64(5), msg.Epoch)
+		assert.Equal(t, uint64(2), msg.Round)
+		assert.Equal(t, [][]byte{{0x01}, {0x02}}, msg.Signatures)
+
+		// the module decodes the msg as sent
+		_, err = chaintypes.NewMsgClient(conn).Transmit(ctx, msg)
+		require.NoError(t, err)
+		require.Len(t, m.transmitted, 1)
+		assert.Equal(t, msg, m.transmitted[0])
+		assert.Equal(t, []byte{1, 2, 0}, m.transmitted[0].Report.Observers)
+	})
+
+	t.Run("no feed config", func(t *testing.T) {
+		m := &fakeOCRModule{feedConfigInfo: &chaintypes.QueryFeedConfigInfoResponse{}}
+		transmitter := NewCosmosModuleTransmitter(chaintypes.NewQueryClient(newOCRModuleConn(t, m)), "feed", sender, "inj", enqueuer, logger.Test(t))
+		_, _, err := transmitter.LatestConfigDigestAndEpoch(ctx)
+		require.ErrorContains(t, err, "feed config not found")
+	})
+}
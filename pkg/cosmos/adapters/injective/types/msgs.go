@@ -4,8 +4,20 @@ import (
 	errors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
 )
 
+// mustParseAnyPrefix is like sdk.MustAccAddressFromBech32, but accepts the prefix of any chain rather than only
+// that of the global sdk.Config, since msgs are shared by chains with different prefixes.
+func mustParseAnyPrefix(address string) sdk.AccAddress {
+	addr, err := params.ParseAnyPrefix(address)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
 const (
 	TypeMsgCreateFeed             = "createFeed"
 	TypeMsgUpdateFeed             = "updateFeed"
@@ -50,7 +62,7 @@ func (msg *MsgCreateFeed) GetSignBytes() []byte {
 
 // GetSigners implements the sdk.Msg interface. It defines whose signature is required
 func (msg MsgCreateFeed) GetSigners() []sdk.AccAddress {
-	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	sender := mustParseAnyPrefix(msg.Sender)
 	return []sdk.AccAddress{sender}
 }
 
@@ -72,7 +84,7 @@ func (msg MsgUpdateFeed) ValidateBasic() error {
 
 	seenTransmitters := make(map[string]struct{}, len(msg.Transmitters))
 	for _, transmitter := range msg.Transmitters {
-		addr, err := sdk.AccAddressFromBech32(transmitter)
+		addr, err := params.ParseAnyPrefix(transmitter)
 		if err != nil {
 			return err
 		}
@@ -85,7 +97,7 @@ func (msg MsgUpdateFeed) ValidateBasic() error {
 
 	seenSigners := make(map[string]struct{}, len(msg.Signers))
 	for _, signer := range msg.Signers {
-		addr, err := sdk.AccAddressFromBech32(signer)
+		addr, err := params.ParseAnyPrefix(signer)
 		if err != nil {
 			return err
 		}
@@ -113,13 +125,13 @@ func (msg MsgUpdateFeed) ValidateBasic() error {
 	}
 
 	if msg.FeedAdmin != "" {
-		if _, err := sdk.AccAddressFromBech32(msg.FeedAdmin); err != nil {
+		if _, err := params.ParseAnyPrefix(msg.FeedAdmin); err != nil {
 			return err
 		}
 	}
 
 	if msg.BillingAdmin != "" {
-		if _, err := sdk.AccAddressFromBech32(msg.BillingAdmin); err != nil {
+		if _, err := params.ParseAnyPrefix(msg.BillingAdmin); err != nil {
 			return err
 		}
 	}
@@ -134,7 +146,7 @@ func (msg *MsgUpdateFeed) GetSignBytes() []byte {
 
 // GetSigners implements the sdk.Msg interface. It defines whose signature is required
 func (msg MsgUpdateFeed) GetSigners() []sdk.AccAddress {
-	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	sender := mustParseAnyPrefix(msg.Sender)
 	return []sdk.AccAddress{sender}
 }
 
@@ -184,7 +196,7 @@ func (msg *MsgTransmit) GetSignBytes() []byte {
 
 // GetSigners implements the sdk.Msg interface. It defines whose signature is required
 func (msg MsgTransmit) GetSigners() []sdk.AccAddress {
-	transmitter := sdk.MustAccAddressFromBech32(msg.Transmitter)
+	transmitter := mustParseAnyPrefix(msg.Transmitter)
 	return []sdk.AccAddress{transmitter}
 }
 
@@ -218,7 +230,7 @@ func (msg *MsgFundFeedRewardPool) GetSignBytes() []byte {
 
 // GetSigners implements the sdk.Msg interface. It defines whose signature is required
 func (msg MsgFundFeedRewardPool) GetSigners() []sdk.AccAddress {
-	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	sender := mustParseAnyPrefix(msg.Sender)
 	return []sdk.AccAddress{sender}
 }
 
@@ -252,7 +264,7 @@ func (msg *MsgWithdrawFeedRewardPool) GetSignBytes() []byte {
 
 // GetSigners implements the sdk.Msg interface. It defines whose signature is required
 func (msg MsgWithdrawFeedRewardPool) GetSigners() []sdk.AccAddress {
-	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	sender := mustParseAnyPrefix(msg.Sender)
 	return []sdk.AccAddress{sender}
 }
 
@@ -278,7 +290,7 @@ func (msg MsgSetPayees) ValidateBasic() error {
 
 	seenTransmitters := make(map[string]struct{}, len(msg.Transmitters))
 	for _, transmitter := range msg.Transmitters {
-		addr, err := sdk.AccAddressFromBech32(transmitter)
+		addr, err := params.ParseAnyPrefix(transmitter)
 		if err != nil {
 			return err
 		}
@@ -291,7 +303,7 @@ func (msg MsgSetPayees) ValidateBasic() error {
 
 	seenPayees := make(map[string]struct{}, len(msg.Payees))
 	for _, payee := range msg.Payees {
-		addr, err := sdk.AccAddressFromBech32(payee)
+		addr, err := params.ParseAnyPrefix(payee)
 		if err != nil {
 			return err
 		}
@@ -312,7 +324,7 @@ func (msg *MsgSetPayees) GetSignBytes() []byte {
 
 // GetSigners implements the sdk.Msg interface. It defines whose signature is required
 func (msg MsgSetPayees) GetSigners() []sdk.AccAddress {
-	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	sender := mustParseAnyPrefix(msg.Sender)
 	return []sdk.AccAddress{sender}
 }
 
@@ -332,11 +344,11 @@ func (msg MsgTransferPayeeship) ValidateBasic() error {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "feedId not valid")
 	}
 
-	if _, err := sdk.AccAddressFromBech32(msg.Transmitter); err != nil {
+	if _, err := params.ParseAnyPrefix(msg.Transmitter); err != nil {
 		return errors.Wrap(sdkerrors.ErrInvalidAddress, msg.Transmitter)
 	}
 
-	if _, err := sdk.AccAddressFromBech32(msg.Proposed); err != nil {
+	if _, err := params.ParseAnyPrefix(msg.Proposed); err != nil {
 		return errors.Wrap(sdkerrors.ErrInvalidAddress, msg.Proposed)
 	}
 
@@ -354,7 +366,7 @@ func (msg *MsgTransferPayeeship) GetSignBytes() []byte {
 
 // GetSigners implements the sdk.Msg interface. It defines whose signature is required
 func (msg MsgTransferPayeeship) GetSigners() []sdk.AccAddress {
-	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	sender := mustParseAnyPrefix(msg.Sender)
 	return []sdk.AccAddress{sender}
 }
 
@@ -374,7 +386,7 @@ func (msg MsgAcceptPayeeship) ValidateBasic() error {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "feedId not valid")
 	}
 
-	if _, err := sdk.AccAddressFromBech32(msg.Transmitter); err != nil {
+	if _, err := params.ParseAnyPrefix(msg.Transmitter); err != nil {
 		return errors.Wrap(sdkerrors.ErrInvalidAddress, msg.Transmitter)
 	}
 
@@ -388,6 +400,6 @@ func (msg *MsgAcceptPayeeship) GetSignBytes() []byte {
 
 // GetSigners implements the sdk.Msg interface. It defines whose signature is required
 func (msg MsgAcceptPayeeship) GetSigners() []sdk.AccAddress {
-	sender := sdk.MustAccAddressFromBech32(msg.Payee)
+	sender := mustParseAnyPrefix(msg.Payee)
 	return []sdk.AccAddress{sender}
 }
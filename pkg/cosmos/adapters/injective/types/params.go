@@ -5,6 +5,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
 )
 
 var _ paramtypes.ParamSet = &Params{}
@@ -96,7 +98,7 @@ func validateModuleAdmin(i interface{}) error {
 		return nil
 	}
 
-	if _, err := sdk.AccAddressFromBech32(v); err != nil {
+	if _, err := params.ParseAnyPrefix(v); err != nil {
 		return err
 	}
 
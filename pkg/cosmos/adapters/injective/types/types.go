@@ -9,6 +9,8 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/gogo/protobuf/proto"
 	"golang.org/x/crypto/sha3"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
 )
 
 const FeedIDMaxLength = 20
@@ -57,7 +59,7 @@ func (cfg *FeedConfig) ValidTransmitters() map[string]struct{} {
 func (cfg *FeedConfig) TransmitterFromSigner() map[string]sdk.AccAddress {
 	transmitterFromSigner := make(map[string]sdk.AccAddress)
 	for idx, signer := range cfg.Signers {
-		addr, _ := sdk.AccAddressFromBech32(cfg.Transmitters[idx])
+		addr, _ := params.ParseAnyPrefix(cfg.Transmitters[idx])
 		transmitterFromSigner[signer] = addr
 	}
 	return transmitterFromSigner
@@ -86,13 +88,13 @@ func (cfg *FeedConfig) ValidateBasic() error {
 	}
 
 	if len(cfg.ModuleParams.FeedAdmin) > 0 {
-		if _, err := sdk.AccAddressFromBech32(cfg.ModuleParams.FeedAdmin); err != nil {
+		if _, err := params.ParseAnyPrefix(cfg.ModuleParams.FeedAdmin); err != nil {
 			return err
 		}
 	}
 
 	if len(cfg.ModuleParams.BillingAdmin) > 0 {
-		if _, err := sdk.AccAddressFromBech32(cfg.ModuleParams.BillingAdmin); err != nil {
+		if _, err := params.ParseAnyPrefix(cfg.ModuleParams.BillingAdmin); err != nil {
 			return err
 		}
 	}
@@ -111,7 +113,7 @@ func (cfg *FeedConfig) ValidateBasic() error {
 
 	seenTransmitters := make(map[string]struct{}, len(cfg.Transmitters))
 	for _, transmitter := range cfg.Transmitters {
-		addr, err := sdk.AccAddressFromBech32(transmitter)
+		addr, err := params.ParseAnyPrefix(transmitter)
 		if err != nil {
 			return err
 		}
@@ -124,7 +126,7 @@ func (cfg *FeedConfig) ValidateBasic() error {
 
 	seenSigners := make(map[string]struct{}, len(cfg.Signers))
 	for _, signer := range cfg.Signers {
-		addr, err := sdk.AccAddressFromBech32(signer)
+		addr, err := params.ParseAnyPrefix(signer)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,91 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+)
+
+// ConfigProviderFactory returns a ConfigProvider for the OCR2 job of args.
+type ConfigProviderFactory func(ctx context.Context, lggr logger.Logger, chain Chain, args types.RelayArgs) (types.ConfigProvider, error)
+
+// MedianProviderFactory returns a MedianProvider for the OCR2 median job of rargs and pargs.
+type MedianProviderFactory func(ctx context.Context, lggr logger.Logger, chain Chain, rargs types.RelayArgs, pargs types.PluginArgs) (types.MedianProvider, error)
+
+// Family holds the provider constructors of a contract kind on a chain family. A nil constructor means the
+// family does not support that product.
+type Family struct {
+	NewConfigProvider ConfigProviderFactory
+	NewMedianProvider MedianProviderFactory
+}
+
+// familyKey identifies the adapters of a ContractKind on a ChainFamily.
+type familyKey struct {
+	family, kind string
+}
+
+func (k familyKey) String() string { return k.family + "/" + k.kind }
+
+var (
+	familiesMu sync.RWMutex
+	families   = map[familyKey]Family{}
+)
+
+// Register makes the adapters of a chain family available to the chains configured with its name as ChainFamily,
+// and kind as ContractKind. It is meant to be called from the init function of the adapter package, and panics if
+// the pair is already registered.
+func Register(name, kind string, family Family) {
+	familiesMu.Lock()
+	defer familiesMu.Unlock()
+	key := familyKey{name, kind}
+	if _, ok := families[key]; ok {
+		panic(fmt.Sprintf("adapters: contract kind %q of chain family %q registered twice", kind, name))
+	}
+	families[key] = family
+}
+
+// LookupFamily returns the adapters registered for the contract kind of the chain family name.
+func LookupFamily(name, kind string) (Family, error) {
+	familiesMu.RLock()
+	defer familiesMu.RUnlock()
+	family, ok := families[familyKey{name, kind}]
+	if !ok {
+		keys := make([]string, 0, len(families))
+		for k := range families {
+			keys = append(keys, k.String())
+		}
+		sort.Strings(keys)
+		return Family{}, fmt.Errorf("unknown contract kind %q of chain family %q, must be one of %v", kind, name, keys)
+	}
+	return family, nil
+}
+
+// NewConfigProvider returns a ConfigProvider from the adapters of the chain family and contract kind of chain.
+func NewConfigProvider(ctx context.Context, lggr logger.Logger, chain Chain, args types.RelayArgs) (types.ConfigProvider, error) {
+	name, kind := chain.Config().ChainFamily(), chain.Config().ContractKind()
+	family, err := LookupFamily(name, kind)
+	if err != nil {
+		return nil, err
+	}
+	if family.NewConfigProvider == nil {
+		return nil, fmt.Errorf("config providers are not supported for contract kind %q of chain family %q", kind, name)
+	}
+	return family.NewConfigProvider(ctx, lggr, chain, args)
+}
+
+// NewMedianProvider returns a MedianProvider from the adapters of the chain family and contract kind of chain.
+func NewMedianProvider(ctx context.Context, lggr logger.Logger, chain Chain, rargs types.RelayArgs, pargs types.PluginArgs) (types.MedianProvider, error) {
+	name, kind := chain.Config().ChainFamily(), chain.Config().ContractKind()
+	family, err := LookupFamily(name, kind)
+	if err != nil {
+		return nil, err
+	}
+	if family.NewMedianProvider == nil {
+		return nil, fmt.Errorf("median is not supported for contract kind %q of chain family %q", kind, name)
+	}
+	return family.NewMedianProvider(ctx, lggr, chain, rargs, pargs)
+}
@@ -0,0 +1,78 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/config"
+)
+
+type fakeChain struct {
+	Chain
+	cfg config.Config
+}
+
+func (c fakeChain) Config() config.Config { return c.cfg }
+
+func newFakeChain(bech32Prefix, family, kind string) fakeChain {
+	cfg := &config.TOMLConfig{Chain: config.Chain{Bech32Prefix: &bech32Prefix, ChainFamily: &family, ContractKind: &kind}}
+	cfg.Chain.SetDefaults()
+	return fakeChain{cfg: cfg}
+}
+
+type fakeConfigProvider struct {
+	types.ConfigProvider
+	family string
+}
+
+func TestRegistry(t *testing.T) {
+	ctx, lggr := context.Background(), logger.Test(t)
+	newConfigProvider := func(family string) ConfigProviderFactory {
+		return func(context.Context, logger.Logger, Chain, types.RelayArgs) (types.ConfigProvider, error) {
+			return fakeConfigProvider{family: family}, nil
+		}
+	}
+	Register("test-full", "contract", Family{NewConfigProvider: newConfigProvider("test-full")})
+	Register("test-full", "module", Family{NewConfigProvider: newConfigProvider("test-full-module")})
+	Register("test-config-only", "contract", Family{NewConfigProvider: newConfigProvider("test-config-only")})
+	assert.Panics(t, func() { Register("test-full", "contract", Family{}) })
+
+	t.Run("config provider", func(t *testing.T) {
+		cp, err := NewConfigProvider(ctx, lggr, newFakeChain("wasm", "test-full", "contract"), types.RelayArgs{})
+		require.NoError(t, err)
+		assert.Equal(t, "test-full", cp.(fakeConfigProvider).family)
+		cp, err = NewConfigProvider(ctx, lggr, newFakeChain("wasm", "test-full", "module"), types.RelayArgs{})
+		require.NoError(t, err)
+		assert.Equal(t, "test-full-module", cp.(fakeConfigProvider).family)
+	})
+
+	t.Run("unsupported product", func(t *testing.T) {
+		_, err := NewMedianProvider(ctx, lggr, newFakeChain("wasm", "test-config-only", "contract"), types.RelayArgs{}, types.PluginArgs{})
+		assert.ErrorContains(t, err, `median is not supported for contract kind "contract" of chain family "test-config-only"`)
+	})
+
+	t.Run("unknown family", func(t *testing.T) {
+		_, err := NewConfigProvider(ctx, lggr, newFakeChain("wasm", "unknown", "contract"), types.RelayArgs{})
+		assert.ErrorContains(t, err, `unknown contract kind "contract" of chain family "unknown"`)
+		_, err = NewConfigProvider(ctx, lggr, newFakeChain("wasm", "test-config-only", "module"), types.RelayArgs{})
+		assert.ErrorContains(t, err, `unknown contract kind "module" of chain family "test-config-only"`)
+	})
+
+	t.Run("default family", func(t *testing.T) {
+		assert.Equal(t, config.ChainFamilyCosmWasm, newFakeChain("wasm", "", "").Config().ChainFamily())
+		assert.Equal(t, config.ChainFamilyInjective, newFakeChain("inj", "", "").Config().ChainFamily())
+		assert.Equal(t, "test-full", newFakeChain("inj", "test-full", "").Config().ChainFamily())
+	})
+
+	t.Run("default contract kind", func(t *testing.T) {
+		assert.Equal(t, config.ContractKindCosmWasm, newFakeChain("wasm", "", "").Config().ContractKind())
+		assert.Equal(t, config.ContractKindModule, newFakeChain("inj", "", "").Config().ContractKind())
+		assert.Equal(t, config.ContractKindCosmWasm, newFakeChain("inj", "", config.ContractKindCosmWasm).Config().ContractKind())
+	})
+}
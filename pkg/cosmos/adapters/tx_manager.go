@@ -14,6 +14,8 @@ type Msg struct {
 
 	// In memory only
 	DecodedMsg cosmosSDK.Msg
+	// Refusal is the last reason the msg was refused to be sent while Started, e.g. for exceeding a fee cap.
+	Refusal error
 }
 
 type Msgs []Msg
@@ -47,7 +49,7 @@ type MsgEnqueuer interface {
 type TxManager interface {
 	MsgEnqueuer
 
-	// GetMsgs returns any messages matching ids.
+	// GetMsgs returns any messages matching ids, with the reason of any refusal to send them.
 	GetMsgs(ctx context.Context, ids ...int64) (Msgs, error)
 	// GasPrice returns the gas price in ucosm.
 	GasPrice() (cosmosSDK.DecCoin, error)
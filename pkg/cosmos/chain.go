@@ -2,10 +2,14 @@ package cosmos
 
 import (
 	"context"
-	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"math/big"
-	"time"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
 
 	"github.com/pelletier/go-toml/v2"
 	"github.com/pkg/errors"
@@ -19,7 +23,8 @@ import (
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/adapters"
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/client"
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/config"
-	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/db"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/monitor"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/txm"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/chains"
@@ -29,14 +34,6 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 )
 
-// defaultRequestTimeout is the default Cosmos client timeout.
-// Note that while the cosmos node is processing a heavy block,
-// requests can be delayed significantly (https://github.com/tendermint/tendermint/issues/6899),
-// however there's nothing we can do but wait until the block is processed.
-// So we set a fairly high timeout here.
-// TODO(BCI-979): Remove this, or make this configurable with the updated client.
-const defaultRequestTimeout = 30 * time.Second
-
 // Chain is a wrap for easy use in other places in the core node
 type Chain = adapters.Chain
 
@@ -78,34 +75,189 @@ var _ adapters.Chain = (*chain)(nil)
 
 type chain struct {
 	services.StateMachine
-	id   string
-	cfg  *config.TOMLConfig
-	txm  *txm.Txm
-	lggr logger.Logger
+	id       string
+	cfg      *config.Reloadable
+	gpe      atomic.Pointer[client.ComposedGasPriceEstimator]
+	txm      *txm.Txm
+	heads    *client.HeadTracker
+	balances *monitor.BalanceMonitor
+	light    *client.LightClient // nil unless a trusted header is configured
+	lggr     logger.Logger
+
+	transports nodeTransports
+	checks     nodeChecks
+	stop       services.StopChan
+	wg         sync.WaitGroup // preflight checks
+
+	updateMu sync.Mutex // serializes config updates
 }
 
 func newChain(id string, cfg *config.TOMLConfig, db *sqlx.DB, ks loop.Keystore, lggr logger.Logger) (*chain, error) {
 	lggr = logger.With(lggr, "cosmosChainID", id)
+	// the denominations of the gas token are converted between by the txm
+	if err := params.RegisterDenoms(cfg.GasToken()); err != nil {
+		return nil, fmt.Errorf("invalid gas token: %w", err)
+	}
 	var ch = chain{
 		id:   id,
-		cfg:  cfg,
+		cfg:  config.NewReloadable(cfg),
 		lggr: logger.Named(lggr, "Chain"),
+		stop: make(services.StopChan),
 	}
+	ch.transports.lggr = ch.lggr
 	tc := func() (client.ReaderWriter, error) {
 		return ch.getClient("")
 	}
-	gpe := client.NewMustGasPriceEstimator([]client.GasPricesEstimator{
-		client.NewClosureGasPriceEstimator(func() (map[string]sdk.DecCoin, error) {
-			return map[string]sdk.DecCoin{
-				cfg.GasToken(): sdk.NewDecCoinFromDec(cfg.GasToken(), cfg.FallbackGasPrice()),
-			}, nil
-		}),
+	var verifier client.LightBlockVerifier
+	if height := cfg.LightClientTrustedHeight(); height > 0 {
+		hash, err := hex.DecodeString(cfg.LightClientTrustedHash())
+		if err != nil {
+			return nil, fmt.Errorf("invalid light client trusted hash: %w", err)
+		}
+		urls := make(map[string]string, len(cfg.Nodes))
+		for _, n := range cfg.Nodes {
+			if servesReads(n) {
+				urls[*n.Name] = (*url.URL)(n.TendermintURL).String()
+			}
+		}
+		var store client.LightClientTrustStore
+		if db != nil && cfg.LightClientPersistTrust() {
+			store = newLightClientORM(id, db)
+		}
+		ch.light = client.NewLightClient(id, client.LightClientTrust{
+			Height: height,
+			Hash:   hash,
+			Period: cfg.LightClientTrustPeriod(),
+		}, urls, store, lggr)
+		verifier = ch.light
+	}
+	// allow a missed block before falling back to querying the node status
+	ch.heads = client.NewHeadTracker(func() (client.Reader, error) {
+		return ch.getClient("")
+	}, verifier, 2*cfg.BlockRate(), lggr)
+	gpe, err := ch.newGasPriceEstimator(cfg)
+	if err != nil {
+		return nil, err
+	}
+	ch.gpe.Store(gpe)
+	// the txm reads the estimator of the current config
+	currentGPE := client.NewClosureGasPriceEstimator(func() (map[string]sdk.DecCoin, error) {
+		return ch.gpe.Load().GasPrices()
+	})
+	ch.txm = txm.NewTxm(db, tc, ch.heads, currentGPE, ch.id, ch.cfg, ks, lggr)
+	ch.balances = monitor.NewBalanceMonitor(id, ch.cfg, ch.txm, func() (client.Reader, error) {
+		return ch.getClient("")
 	}, lggr)
-	ch.txm = txm.NewTxm(db, tc, *gpe, ch.id, cfg, ks, lggr)
 
 	return &ch, nil
 }
 
+// newGasPriceEstimator returns the gas price estimator configured by cfg.
+func (c *chain) newGasPriceEstimator(cfg config.Config) (*client.ComposedGasPriceEstimator, error) {
+	getReader := func() (client.Reader, error) {
+		return c.getClient("")
+	}
+	var stages []client.GasPriceStage
+	if source := cfg.GasPriceSource(); source != "" {
+		dynamic, err := client.NewDynamicGasPriceEstimator(getReader, client.DynamicGasPriceConfig{
+			Source:     source,
+			Denom:      cfg.GasToken(),
+			Multiplier: cfg.GasPriceMultiplier(),
+			Floor:      cfg.MinGasPrice(),
+			Ceiling:    cfg.MaxGasPrice(),
+		}, c.lggr)
+		if err != nil {
+			return nil, err
+		}
+		stages = append(stages, client.GasPriceStage{Estimator: dynamic, Weight: cfg.GasPriceSourceWeight(), MaxAge: cfg.GasPriceMaxAge()})
+	}
+	if blocks := cfg.GasPricePercentileBlocks(); blocks > 0 {
+		percentile, err := client.NewPercentileGasPriceEstimator(getReader, client.PercentileGasPriceConfig{
+			Blocks:     blocks,
+			Percentile: cfg.GasPricePercentile(),
+			TTL:        cfg.GasPricePercentileTTL(),
+			Denom:      cfg.GasToken(),
+			Floor:      cfg.MinGasPrice(),
+			Ceiling:    cfg.MaxGasPrice(),
+		}, c.lggr)
+		if err != nil {
+			return nil, err
+		}
+		stages = append(stages, client.GasPriceStage{Estimator: percentile, Weight: cfg.GasPricePercentileWeight(), MaxAge: cfg.GasPriceMaxAge()})
+	}
+	// the configured fallback price has no weight, so it is only used when no other estimator has a price.
+	// It is read from the current config, so it is updated without rebuilding the estimator.
+	stages = append(stages, client.GasPriceStage{Estimator: client.NewClosureGasPriceEstimator(func() (map[string]sdk.DecCoin, error) {
+		return map[string]sdk.DecCoin{
+			c.cfg.GasToken(): sdk.NewDecCoinFromDec(c.cfg.GasToken(), c.cfg.FallbackGasPrice()),
+		}, nil
+	})})
+	return client.NewComposedGasPriceEstimator(stages, c.lggr), nil
+}
+
+// UpdateConfig validates cfg and applies it. Nodes, gas prices, batching and timeouts are updated,
+// while settings which are only read when the chain is built are rejected with config.ErrRestartRequired.
+// Unset settings of cfg are set to their defaults, like those of a new chain.
+func (c *chain) UpdateConfig(cfg *config.TOMLConfig) error {
+	c.updateMu.Lock()
+	defer c.updateMu.Unlock()
+	cfg.Chain.SetDefaults()
+	current := c.cfg.Current()
+	if err := current.ValidateUpdate(cfg); err != nil {
+		return fmt.Errorf("invalid config update: %w", err)
+	}
+	changes := current.Diff(cfg)
+	if len(changes) == 0 {
+		return nil
+	}
+	var gpe *client.ComposedGasPriceEstimator
+	if slices.ContainsFunc(changes, isGasPriceEstimatorChange) {
+		var err error
+		if gpe, err = c.newGasPriceEstimator(cfg); err != nil {
+			return fmt.Errorf("invalid gas price estimator config: %w", err)
+		}
+	}
+
+	c.cfg.Store(cfg)
+	if gpe != nil {
+		c.gpe.Store(gpe)
+	}
+	if slices.ContainsFunc(changes, isNodeChange) {
+		// results of unchanged nodes are kept, since they are tied to the node config
+		c.IfStarted(func() {
+			var nodes config.Nodes
+			for _, n := range cfg.Nodes {
+				if _, ok := c.checks.get(n); !ok {
+					nodes = append(nodes, n)
+				}
+			}
+			c.checkNodes(nodes)
+		})
+	}
+	diff := make([]string, len(changes))
+	for i, change := range changes {
+		diff[i] = change.String()
+	}
+	c.lggr.Infow("Updated config", "changes", diff)
+	return nil
+}
+
+// isGasPriceEstimatorChange returns whether change requires rebuilding the gas price estimator.
+func isGasPriceEstimatorChange(change config.Change) bool {
+	switch change.Name {
+	case "FallbackGasPrice":
+		return false
+	case "MinGasPrice", "MaxGasPrice":
+		return true
+	}
+	return strings.HasPrefix(change.Name, "GasPrice")
+}
+
+// isNodeChange returns whether change adds, removes or updates a node.
+func isNodeChange(change config.Change) bool {
+	return strings.HasPrefix(change.Name, "Nodes.")
+}
+
 func (c *chain) Name() string {
 	return c.lggr.Name()
 }
@@ -118,6 +270,7 @@ func (c *chain) ChainID() string {
 	return c.id
 }
 
+// Config returns the current config, which reflects updates.
 func (c *chain) Config() config.Config {
 	return c.cfg
 }
@@ -126,41 +279,87 @@ func (c *chain) TxManager() adapters.TxManager {
 	return c.txm
 }
 
+func (c *chain) HeadTracker() client.HeadReader {
+	return c.heads
+}
+
+func (c *chain) LightBlockVerifier() client.LightBlockVerifier {
+	if c.light == nil {
+		return nil // rather than a nil *LightClient
+	}
+	return c.light
+}
+
 func (c *chain) Reader(name string) (client.Reader, error) {
 	return c.getClient(name)
 }
 
 // getClient returns a client, optionally requiring a specific node by name.
+// Otherwise the client uses a node serving reads. Height-pinned queries are routed to an archive node,
+// and broadcasts to the node of the lowest priority.
 func (c *chain) getClient(name string) (client.ReaderWriter, error) {
-	var node db.Node
+	cfg := c.cfg.Current()
+	var node *config.Node
 	if name == "" { // Any node
-		nodes, err := c.cfg.ListNodes()
+		var err error
+		node, err = selectNode(cfg.Nodes, servesReads)
 		if err != nil {
-			return nil, fmt.Errorf("failed to list nodes: %w", err)
+			return nil, err
 		}
-		if len(nodes) == 0 {
+		if node == nil {
 			return nil, errors.New("no nodes available")
 		}
-		nodeIndex, err := rand.Int(rand.Reader, big.NewInt(int64(len(nodes))))
+	} else { // Named node
+		i := slices.IndexFunc(cfg.Nodes, func(n *config.Node) bool { return *n.Name == name })
+		if i == -1 {
+			return nil, fmt.Errorf("failed to get node named %s: node not found", name)
+		}
+		node = cfg.Nodes[i]
+	}
+
+	var opts []client.ClientOption
+	// route height-pinned queries to an archive node, unless this is one
+	if !servesHistory(node) {
+		archive, err := selectNode(cfg.Nodes, servesHistory)
+		if err != nil {
+			return nil, err
+		}
+		if archive != nil {
+			archiveClient, err := c.newNodeClient(archive)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create archive node client: %w", err)
+			}
+			opts = append(opts, client.WithArchiveClient(archiveClient))
+			c.lggr.Debugw("Routing historical queries to archive node", "name", *archive.Name)
+		}
+	}
+	if cfg.BroadcastFanOut() {
+		broadcasters, err := c.broadcastClients(cfg.Nodes)
 		if err != nil {
-			return nil, fmt.Errorf("could not generate a random node index: %w", err)
+			return nil, err
 		}
-		node = nodes[nodeIndex.Int64()]
-	} else { // Named node
-		var err error
-		node, err = c.cfg.GetNode(name)
+		opts = append(opts, client.WithBroadcastClient(broadcasters...))
+	} else {
+		// route broadcasts to a preferred node, like a send-only node, unless this is one
+		broadcaster, err := selectNode(cfg.Nodes, servesBroadcasts)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get node named %s: %w", name, err)
+			return nil, err
 		}
-		if node.CosmosChainID != c.id {
-			return nil, fmt.Errorf("failed to create client for chain %s with node %s: wrong chain id %s", c.id, name, node.CosmosChainID)
+		if broadcaster != nil && broadcaster.NodePriority() < node.NodePriority() {
+			broadcastClient, err := c.newNodeClient(broadcaster)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create broadcast node client: %w", err)
+			}
+			opts = append(opts, client.WithBroadcastClient(broadcastClient))
+			c.lggr.Debugw("Routing broadcasts to node", "name", *broadcaster.Name, "role", broadcaster.NodeRole())
 		}
 	}
-	client, err := client.NewClient(c.id, node.TendermintURL, defaultRequestTimeout, logger.Named(c.lggr, "Client."+name))
+
+	client, err := c.newNodeClient(node, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create client: %w", err)
 	}
-	c.lggr.Debugw("Created client", "name", node.Name, "tendermint-url", node.TendermintURL)
+	c.lggr.Debugw("Created client", "name", *node.Name, "role", node.NodeRole(), "tendermint-url", node.TendermintURL)
 	return client, nil
 }
 
@@ -168,39 +367,80 @@ func (c *chain) getClient(name string) (client.ReaderWriter, error) {
 func (c *chain) Start(ctx context.Context) error {
 	return c.StartOnce("Chain", func() error {
 		c.lggr.Debug("Starting")
-		return c.txm.Start(ctx)
+		if c.light != nil {
+			if err := c.light.Start(ctx); err != nil {
+				return err
+			}
+		}
+		if err := c.heads.Start(ctx); err != nil {
+			return err
+		}
+		if err := c.txm.Start(ctx); err != nil {
+			return err
+		}
+		if err := c.balances.Start(ctx); err != nil {
+			return err
+		}
+		c.checkNodes(c.cfg.Current().Nodes)
+		c.wg.Add(1)
+		go c.recheckNodes()
+		return nil
 	})
 }
 
 func (c *chain) Close() error {
 	return c.StopOnce("Chain", func() error {
 		c.lggr.Debug("Stopping")
-		return c.txm.Close()
+		err := multierr.Combine(c.balances.Close(), c.txm.Close(), c.heads.Close())
+		if c.light != nil {
+			err = multierr.Append(err, c.light.Close())
+		}
+		close(c.stop)
+		c.wg.Wait()
+		return multierr.Append(err, c.transports.Close())
 	})
 }
 
 func (c *chain) Ready() error {
-	return multierr.Combine(
+	err := multierr.Combine(
 		c.StateMachine.Ready(),
+		c.heads.Ready(),
 		c.txm.Ready(),
+		c.balances.Ready(),
 	)
+	if c.light != nil {
+		err = multierr.Append(err, c.light.Ready())
+	}
+	return err
 }
 
 func (c *chain) HealthReport() map[string]error {
 	m := map[string]error{c.Name(): c.Healthy()}
+	if c.light != nil {
+		services.CopyHealth(m, c.light.HealthReport())
+	}
+	services.CopyHealth(m, c.heads.HealthReport())
 	services.CopyHealth(m, c.txm.HealthReport())
+	services.CopyHealth(m, c.balances.HealthReport())
+	// nodes with pending preflight checks are left out
+	for _, n := range c.cfg.Current().Nodes {
+		if err, ok := c.checks.get(n); ok {
+			m[c.Name()+".Node."+*n.Name] = err
+		}
+	}
 	return m
 }
 
 // ChainService interface
 func (c *chain) GetChainStatus(ctx context.Context) (types.ChainStatus, error) {
-	toml, err := c.cfg.TOMLString()
+	cfg := c.cfg.Current()
+	toml, err := cfg.TOMLString()
 	if err != nil {
 		return types.ChainStatus{}, err
 	}
 	return types.ChainStatus{
 		ID:      c.id,
-		Enabled: *c.cfg.Enabled,
+		Enabled: *cfg.Enabled,
 		Config:  toml,
 	}, nil
 }
@@ -209,11 +449,12 @@ func (c *chain) ListNodeStatuses(ctx context.Context, pageSize int32, pageToken
 }
 
 func (c *chain) Transact(ctx context.Context, from, to string, amount *big.Int, balanceCheck bool) error {
-	fromAcc, err := sdk.AccAddressFromBech32(from)
+	bech32 := params.Bech32Prefix(c.cfg.Bech32Prefix())
+	fromAcc, err := bech32.Parse(from)
 	if err != nil {
 		return fmt.Errorf("failed to parse from account: %s", fromAcc)
 	}
-	toAcc, err := sdk.AccAddressFromBech32(to)
+	toAcc, err := bech32.Parse(to)
 	if err != nil {
 		return fmt.Errorf("failed to parse from account: %s", toAcc)
 	}
@@ -246,19 +487,20 @@ func (c *chain) Transact(ctx context.Context, from, to string, amount *big.Int,
 	return nil
 }
 
-// TODO BCF-2602 statuses are static for non-evm chain and should be dynamic
+// listNodeStatuses returns the statuses of the configured nodes, with the state of their preflight checks.
 func (c *chain) listNodeStatuses(start, end int) ([]types.NodeStatus, int, error) {
 	stats := make([]types.NodeStatus, 0)
-	total := len(c.cfg.Nodes)
+	cfg := c.cfg.Current()
+	total := len(cfg.Nodes)
 	if start >= total {
 		return stats, total, chains.ErrOutOfRange
 	}
 	if end > total {
 		end = total
 	}
-	nodes := c.cfg.Nodes[start:end]
+	nodes := cfg.Nodes[start:end]
 	for _, node := range nodes {
-		stat, err := nodeStatus(node, c.ChainID())
+		stat, err := nodeStatus(node, c.ChainID(), c.checks.state(node))
 		if err != nil {
 			return stats, total, err
 		}
@@ -267,10 +509,11 @@ func (c *chain) listNodeStatuses(start, end int) ([]types.NodeStatus, int, error
 	return stats, total, nil
 }
 
-func nodeStatus(n *config.Node, id string) (types.NodeStatus, error) {
+func nodeStatus(n *config.Node, id string, state string) (types.NodeStatus, error) {
 	var s types.NodeStatus
 	s.ChainID = id
 	s.Name = *n.Name
+	s.State = state
 	b, err := toml.Marshal(n)
 	if err != nil {
 		return types.NodeStatus{}, err
@@ -0,0 +1,59 @@
+package cosmos
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	cosmosconfig "github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/config"
+)
+
+func TestChain_UpdateConfig(t *testing.T) {
+	chainID := "chain-1"
+	newConfig := func() *cosmosconfig.TOMLConfig {
+		return &cosmosconfig.TOMLConfig{ChainID: &chainID, Nodes: cosmosconfig.Nodes{testNode("primary", cosmosconfig.NodeRolePrimary, 0)}}
+	}
+	cfg := newConfig()
+	cfg.Chain.SetDefaults()
+	ch, err := newChain(chainID, cfg, nil, nil, logger.Test(t))
+	require.NoError(t, err)
+	gpe := ch.gpe.Load()
+
+	t.Run("defaults", func(t *testing.T) {
+		// unset settings are defaults, rather than nil
+		fallback := decimal.RequireFromString("0.02")
+		update := newConfig()
+		update.Chain.FallbackGasPrice = &fallback
+		require.NoError(t, ch.UpdateConfig(update))
+		assert.Equal(t, cfg.BlocksUntilTxTimeout(), ch.Config().BlocksUntilTxTimeout())
+		assert.Equal(t, sdk.MustNewDecFromStr("0.02"), ch.Config().FallbackGasPrice())
+
+		// the fallback gas price is read from the current config, without rebuilding the estimator
+		assert.Same(t, gpe, ch.gpe.Load())
+		prices, err := ch.gpe.Load().GasPrices()
+		require.NoError(t, err)
+		assert.Equal(t, sdk.NewDecCoinFromDec("ucosm", sdk.MustNewDecFromStr("0.02")), prices["ucosm"])
+	})
+
+	t.Run("gas price estimator", func(t *testing.T) {
+		blocks := int64(10)
+		update := newConfig()
+		update.Chain.GasPricePercentileBlocks = &blocks
+		require.NoError(t, ch.UpdateConfig(update))
+		assert.Equal(t, blocks, ch.Config().GasPricePercentileBlocks())
+		assert.NotSame(t, gpe, ch.gpe.Load())
+	})
+
+	t.Run("restart required", func(t *testing.T) {
+		update := newConfig()
+		update.Chain.BlockRate = config.MustNewDuration(2 * cfg.BlockRate())
+		require.ErrorIs(t, ch.UpdateConfig(update), cosmosconfig.ErrRestartRequired)
+		assert.Equal(t, cfg.BlockRate(), ch.Config().BlockRate())
+	})
+}
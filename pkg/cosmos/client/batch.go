@@ -0,0 +1,181 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultMaxBatchSize is the default maximum number of queries sent in one batch request.
+const DefaultMaxBatchSize = 50
+
+// smartContractStatePath is the ABCI query path routed to the SmartContractState gRPC method.
+const smartContractStatePath = "/cosmwasm.wasm.v1.Query/SmartContractState"
+
+// ContractQuery is a smart query of a contract.
+type ContractQuery struct {
+	ContractAddress sdk.AccAddress
+	QueryMsg        []byte
+}
+
+// ContractQueryResult is the result of a ContractQuery in a batch.
+type ContractQueryResult struct {
+	Data []byte
+	Err  error
+}
+
+// BatchContractState sends queries as a single JSON-RPC batch of abci_query requests, and returns their results in order.
+// An error is returned only if the whole batch fails, while failed queries have their own error.
+// Nodes with a gRPC connection serve queries over it, so queries are sent to them one at a time over gRPC instead,
+// rather than batched over the Tendermint RPC.
+func (c *Client) BatchContractState(queries []ContractQuery) ([]ContractQueryResult, error) {
+	results := make([]ContractQueryResult, len(queries))
+	node, ok := c.clientCtx.Client.(*rpchttp.HTTP)
+	if !ok || c.grpcConn != nil {
+		// batching is only supported over http
+		for i, q := range queries {
+			results[i].Data, results[i].Err = c.ContractState(q.ContractAddress, q.QueryMsg)
+		}
+		return results, nil
+	}
+	reqs := make([][]byte, len(queries))
+	for i, q := range queries {
+		req, err := (&wasmtypes.QuerySmartContractStateRequest{
+			Address:   c.bech32.Address(q.ContractAddress),
+			QueryData: q.QueryMsg,
+		}).Marshal()
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode query %d: %w", i, err)
+		}
+		reqs[i] = req
+	}
+	responses, err := retry(c, "BatchContractState", func() ([]interface{}, error) {
+		batch := node.NewBatch()
+		for _, req := range reqs {
+			if _, err := batch.ABCIQuery(context.Background(), smartContractStatePath, req); err != nil {
+				return nil, err
+			}
+		}
+		return batch.Send(context.Background())
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(responses) != len(queries) {
+		return nil, fmt.Errorf("sent %d queries but got %d responses", len(queries), len(responses))
+	}
+	for i, r := range responses {
+		res, ok := r.(*coretypes.ResultABCIQuery)
+		if !ok {
+			results[i].Err = fmt.Errorf("unexpected response type %T", r)
+			continue
+		}
+		resp := res.Response
+		if !resp.IsOK() {
+			results[i].Err = NewABCIError(resp.Codespace, resp.Code, resp.Log, fmt.Errorf("query failed with code %d: %s", resp.Code, resp.Log))
+			continue
+		}
+		var s wasmtypes.QuerySmartContractStateResponse
+		if err := s.Unmarshal(resp.Value); err != nil {
+			results[i].Err = fmt.Errorf("failed to decode query response: %w", err)
+			continue
+		}
+		results[i].Data = s.Data
+	}
+	return results, nil
+}
+
+// ContractStateBatcher batches the smart queries of concurrent callers, and fans the results back out to them.
+// A batch is sent once it is full, or once the first query in it has waited for the batch window.
+type ContractStateBatcher struct {
+	batch   func([]ContractQuery) ([]ContractQueryResult, error)
+	window  time.Duration
+	maxSize int
+
+	mu      sync.Mutex
+	pending []*pendingQuery
+	timer   *time.Timer
+}
+
+type pendingQuery struct {
+	query  ContractQuery
+	result ContractQueryResult
+	done   chan struct{}
+}
+
+// NewContractStateBatcher returns a ContractStateBatcher sending batches of up to maxSize queries with batch,
+// e.g. Reader.BatchContractState.
+func NewContractStateBatcher(batch func([]ContractQuery) ([]ContractQueryResult, error), window time.Duration, maxSize int) *ContractStateBatcher {
+	if maxSize <= 0 {
+		maxSize = DefaultMaxBatchSize
+	}
+	return &ContractStateBatcher{batch: batch, window: window, maxSize: maxSize}
+}
+
+// ContractState queries contractAddress as part of the next batch.
+func (b *ContractStateBatcher) ContractState(contractAddress sdk.AccAddress, queryMsg []byte) ([]byte, error) {
+	p := &pendingQuery{query: ContractQuery{ContractAddress: contractAddress, QueryMsg: queryMsg}, done: make(chan struct{})}
+	b.mu.Lock()
+	b.pending = append(b.pending, p)
+	switch len(b.pending) {
+	case b.maxSize:
+		batch := b.take()
+		b.mu.Unlock()
+		b.send(batch)
+	case 1:
+		b.timer = time.AfterFunc(b.window, b.flush)
+		b.mu.Unlock()
+	default:
+		b.mu.Unlock()
+	}
+	<-p.done
+	return p.result.Data, p.result.Err
+}
+
+// flush sends the pending queries.
+func (b *ContractStateBatcher) flush() {
+	b.mu.Lock()
+	batch := b.take()
+	b.mu.Unlock()
+	b.send(batch)
+}
+
+// take returns the pending queries, and starts a new batch. b.mu must be held.
+func (b *ContractStateBatcher) take() []*pendingQuery {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	batch := b.pending
+	b.pending = nil
+	return batch
+}
+
+// send sends batch, and completes each query with its result.
+func (b *ContractStateBatcher) send(batch []*pendingQuery) {
+	if len(batch) == 0 {
+		return
+	}
+	queries := make([]ContractQuery, len(batch))
+	for i, p := range batch {
+		queries[i] = p.query
+	}
+	results, err := b.batch(queries)
+	if err == nil && len(results) != len(batch) {
+		err = fmt.Errorf("sent %d queries but got %d results", len(batch), len(results))
+	}
+	for i, p := range batch {
+		if err != nil {
+			p.result.Err = err
+		} else {
+			p.result = results[i]
+		}
+		close(p.done)
+	}
+}
@@ -0,0 +1,126 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+func TestContractStateBatcher(t *testing.T) {
+	contract := sdk.AccAddress("contract____________")
+	query := func(b *ContractStateBatcher, n int) (data []string, errs []error) {
+		data, errs = make([]string, n), make([]error, n)
+		var wg sync.WaitGroup
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				d, err := b.ContractState(contract, []byte(fmt.Sprint(i)))
+				data[i], errs[i] = string(d), err
+			}(i)
+		}
+		wg.Wait()
+		return
+	}
+
+	t.Run("fan out", func(t *testing.T) {
+		var mu sync.Mutex
+		var sizes []int
+		b := NewContractStateBatcher(func(queries []ContractQuery) ([]ContractQueryResult, error) {
+			mu.Lock()
+			sizes = append(sizes, len(queries))
+			mu.Unlock()
+			results := make([]ContractQueryResult, len(queries))
+			for i, q := range queries {
+				if string(q.QueryMsg) == "3" {
+					results[i].Err = errors.New("query failed")
+					continue
+				}
+				results[i].Data = append([]byte("re:"), q.QueryMsg...)
+			}
+			return results, nil
+		}, time.Hour, 5)
+
+		// full batches are sent without waiting for the window
+		data, errs := query(b, 10)
+		for i := range data {
+			if i == 3 {
+				require.ErrorContains(t, errs[i], "query failed")
+				continue
+			}
+			require.NoError(t, errs[i])
+			assert.Equal(t, fmt.Sprintf("re:%d", i), data[i])
+		}
+		assert.Equal(t, []int{5, 5}, sizes)
+	})
+
+	t.Run("window", func(t *testing.T) {
+		var calls atomic.Int32
+		b := NewContractStateBatcher(func(queries []ContractQuery) ([]ContractQueryResult, error) {
+			calls.Add(1)
+			return make([]ContractQueryResult, len(queries)), nil
+		}, 10*time.Millisecond, 0)
+		_, errs := query(b, 3)
+		for _, err := range errs {
+			require.NoError(t, err)
+		}
+		assert.GreaterOrEqual(t, calls.Load(), int32(1))
+	})
+
+	t.Run("batch error", func(t *testing.T) {
+		b := NewContractStateBatcher(func(queries []ContractQuery) ([]ContractQueryResult, error) {
+			return nil, errors.New("connection refused")
+		}, time.Millisecond, 0)
+		_, errs := query(b, 3)
+		for _, err := range errs {
+			require.ErrorContains(t, err, "connection refused")
+		}
+	})
+}
+
+// fakeWasmQueryClient answers smart queries with the query itself.
+type fakeWasmQueryClient struct {
+	wasmtypes.QueryClient
+}
+
+func (fakeWasmQueryClient) SmartContractState(_ context.Context, req *wasmtypes.QuerySmartContractStateRequest, _ ...grpc.CallOption) (*wasmtypes.QuerySmartContractStateResponse, error) {
+	return &wasmtypes.QuerySmartContractStateResponse{Data: append([]byte("re:"), req.QueryData...)}, nil
+}
+
+func TestClient_BatchContractState_GRPC(t *testing.T) {
+	// queries are not batched over the Tendermint RPC of nodes with a gRPC connection
+	var rpcRequests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rpcRequests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(srv.Close)
+	node, err := rpchttp.New(srv.URL, "/websocket")
+	require.NoError(t, err)
+	conn, err := DialGRPC("http://node:9090", time.Second, nil, Auth{}, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, conn.Close()) })
+
+	c := &Client{log: logger.Test(t), bech32: "wasm", grpcConn: conn, wasmClient: fakeWasmQueryClient{}}
+	c.clientCtx = c.clientCtx.WithClient(node)
+	contract := sdk.AccAddress("contract____________")
+	results, err := c.BatchContractState([]ContractQuery{{contract, []byte("a")}, {contract, []byte("b")}})
+	require.NoError(t, err)
+	assert.Equal(t, []ContractQueryResult{{Data: []byte("re:a")}, {Data: []byte("re:b")}}, results)
+	assert.Zero(t, rpcRequests.Load())
+}
@@ -2,9 +2,9 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
-	"regexp"
 	"strconv"
 	"time"
 
@@ -15,6 +15,7 @@ import (
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	libclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 	cosmosclient "github.com/cosmos/cosmos-sdk/client"
 	tmtypes "github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
@@ -22,11 +23,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/jpillora/backoff"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 //go:generate mockery --name ReaderWriter --output ./mocks/
@@ -37,13 +42,42 @@ type ReaderWriter interface {
 
 // Reader provides methods for reading from a cosmos chain.
 type Reader interface {
+	EventSubscriber
 	Account(address sdk.AccAddress) (uint64, uint64, error)
+	// AccountAt is like Account, but reads the account as of the block at height.
+	AccountAt(address sdk.AccAddress, height int64) (uint64, uint64, error)
 	ContractState(contractAddress sdk.AccAddress, queryMsg []byte) ([]byte, error)
+	// ContractStateAt is like ContractState, but queries the contract state as of the block at height.
+	ContractStateAt(contractAddress sdk.AccAddress, queryMsg []byte, height int64) ([]byte, error)
+	// BatchContractState sends many smart queries in a single request. See ContractStateBatcher.
+	BatchContractState(queries []ContractQuery) ([]ContractQueryResult, error)
+	// RawContractState returns the value stored under key by a contract, or nil if there is none.
+	RawContractState(contractAddress sdk.AccAddress, key []byte) ([]byte, error)
 	TxsEvents(events []string, paginationParams *query.PageRequest) (*txtypes.GetTxsEventResponse, error)
+	// TxsEventsPage returns a page of txs matching events. Pages start at 1.
+	// See TxsEventsIterator for walking all pages.
+	TxsEventsPage(events []string, orderBy txtypes.OrderBy, page, limit uint64) (*txtypes.GetTxsEventResponse, error)
 	Tx(hash string) (*txtypes.GetTxResponse, error)
 	LatestBlock() (*tmtypes.GetLatestBlockResponse, error)
+	// Status returns the node status, which includes the latest block height and time.
+	// It is much cheaper than LatestBlock.
+	Status() (*coretypes.ResultStatus, error)
 	BlockByHeight(height int64) (*tmtypes.GetBlockByHeightResponse, error)
 	Balance(addr sdk.AccAddress, denom string) (*sdk.Coin, error)
+	// BalanceAt is like Balance, but reads the balance as of the block at height.
+	BalanceAt(addr sdk.AccAddress, denom string, height int64) (*sdk.Coin, error)
+	// FeeMarketGasPrice returns the current gas price of denom from the x/feemarket module.
+	FeeMarketGasPrice(denom string) (sdk.DecCoin, error)
+	// EIP1559BaseFee returns the current base fee per gas from the Osmosis x/txfees module.
+	EIP1559BaseFee() (sdk.Dec, error)
+	// MinimumGasPrices returns the minimum-gas-prices the node accepts txs with.
+	MinimumGasPrices() (sdk.DecCoins, error)
+	// AddressString returns addr as encoded by the node, with the bech32 prefix of the chain.
+	AddressString(addr sdk.AccAddress) (string, error)
+	// DenomMetadata returns the metadata of denom, which chains do not always register for their native denoms.
+	DenomMetadata(denom string) (*banktypes.Metadata, error)
+	// SupplyOf returns the total supply of denom, which is zero for unknown denoms.
+	SupplyOf(denom string) (*sdk.Coin, error)
 	// TODO: escape hatch for injective client
 	Context() *cosmosclient.Context
 }
@@ -81,6 +115,63 @@ const (
 	DefaultGasLimitMultiplier = 1.5
 )
 
+// RetryConfig configures how Client retries requests which fail with a transient error. See IsTransient.
+// Broadcasts are never retried, since a transient error does not tell whether the tx reached the node.
+type RetryConfig struct {
+	// Attempts is the maximum number of attempts per request. Values below 2 disable retries.
+	Attempts   int
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryConfig is the RetryConfig used by NewClient.
+var DefaultRetryConfig = RetryConfig{
+	Attempts:   3,
+	MinBackoff: 100 * time.Millisecond,
+	MaxBackoff: 2 * time.Second,
+}
+
+// ClientOption configures optional Client behaviour.
+type ClientOption func(*Client)
+
+// WithRetryConfig overrides DefaultRetryConfig.
+func WithRetryConfig(cfg RetryConfig) ClientOption {
+	return func(c *Client) {
+		c.retryCfg = cfg
+	}
+}
+
+// WithArchiveNode routes height-pinned queries, like ContractStateAt, to the archive node at tendermintURL.
+// By default they go to the same node as all other requests, which may have pruned the state.
+func WithArchiveNode(tendermintURL string) ClientOption {
+	return func(c *Client) {
+		c.archiveURL = tendermintURL
+	}
+}
+
+// WithArchiveClient routes height-pinned queries to archive, the client of an archive node.
+// Unlike WithArchiveNode, the archive node keeps its own transport settings.
+func WithArchiveClient(archive *Client) ClientOption {
+	return func(c *Client) {
+		c.archive = archive
+	}
+}
+
+// WithBroadcastClient broadcasts txs through broadcasters, the clients of other nodes, like send-only nodes.
+// With more than one, each tx is broadcast to all of them in parallel, see Broadcast.
+func WithBroadcastClient(broadcasters ...*Client) ClientOption {
+	return func(c *Client) {
+		c.broadcasters = broadcasters
+	}
+}
+
+// WithBech32Prefix encodes addresses in requests with prefix, rather than that of the global sdk.Config.
+func WithBech32Prefix(prefix string) ClientOption {
+	return func(c *Client) {
+		c.bech32 = params.Bech32Prefix(prefix)
+	}
+}
+
 // Client is a cosmos client
 type Client struct {
 	chainID                 string
@@ -90,7 +181,22 @@ type Client struct {
 	wasmClient              wasmtypes.QueryClient
 	bankClient              banktypes.QueryClient
 	tendermintServiceClient tmtypes.ServiceClient
-	log                     logger.Logger
+	// archive clients serve height-pinned queries
+	archiveURL        string
+	archive           *Client
+	archiveAuthClient authtypes.QueryClient
+	archiveWasmClient wasmtypes.QueryClient
+	archiveBankClient banktypes.QueryClient
+	broadcasters      []*Client
+	grpcConn          *grpc.ClientConn
+	websocketURL      string
+	limiter           *RateLimiter
+	health            *NodeHealth
+	auth              Auth
+	subscriber        *subscriber
+	retryCfg          RetryConfig
+	bech32            params.Bech32Prefix
+	log               logger.Logger
 }
 
 // NewClient creates a new cosmos client
@@ -98,45 +204,98 @@ func NewClient(chainID string,
 	tendermintURL string,
 	requestTimeout time.Duration,
 	lggr logger.Logger,
+	opts ...ClientOption,
 ) (*Client, error) {
 	if requestTimeout <= 0 {
 		requestTimeout = DefaultTimeout
 	}
 
-	httpClient, err := libclient.DefaultHTTPClient(tendermintURL)
+	c := &Client{
+		chainID:  chainID,
+		retryCfg: DefaultRetryConfig,
+		log:      lggr,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	clientCtx, err := c.newClientContext(chainID, tendermintURL, requestTimeout)
 	if err != nil {
 		return nil, err
 	}
+	if c.grpcConn != nil {
+		clientCtx = clientCtx.WithGRPCClient(c.grpcConn)
+	}
+	c.clientCtx = clientCtx
+	c.cosmosServiceClient = txtypes.NewServiceClient(clientCtx)
+	c.authClient = authtypes.NewQueryClient(clientCtx)
+	c.wasmClient = wasmtypes.NewQueryClient(clientCtx)
+	c.tendermintServiceClient = tmtypes.NewServiceClient(clientCtx)
+	c.bankClient = banktypes.NewQueryClient(clientCtx)
+
+	wsRemote, wsEndpoint := tendermintURL, "/websocket"
+	if c.websocketURL != "" {
+		if wsRemote, wsEndpoint, err = websocketRemote(c.websocketURL); err != nil {
+			return nil, err
+		}
+	}
+	c.subscriber = newSubscriber(wsRemote, wsEndpoint, requestTimeout, lggr)
+
+	c.archiveAuthClient, c.archiveWasmClient, c.archiveBankClient = c.authClient, c.wasmClient, c.bankClient
+	if c.archive != nil {
+		c.archiveAuthClient, c.archiveWasmClient, c.archiveBankClient = c.archive.authClient, c.archive.wasmClient, c.archive.bankClient
+	} else if c.archiveURL != "" {
+		archiveCtx, err := c.newClientContext(chainID, c.archiveURL, requestTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create archive node client: %w", err)
+		}
+		c.archiveAuthClient = authtypes.NewQueryClient(archiveCtx)
+		c.archiveWasmClient = wasmtypes.NewQueryClient(archiveCtx)
+		c.archiveBankClient = banktypes.NewQueryClient(archiveCtx)
+	}
+	return c, nil
+}
+
+func (c *Client) newClientContext(chainID string, tendermintURL string, requestTimeout time.Duration) (cosmosclient.Context, error) {
+	httpClient, err := libclient.DefaultHTTPClient(tendermintURL)
+	if err != nil {
+		return cosmosclient.Context{}, err
+	}
 	httpClient.Timeout = requestTimeout
+	httpClient.Transport = c.wrapTransport(httpClient.Transport)
 	tmClient, err := rpchttp.NewWithClient(tendermintURL, "/websocket", httpClient)
 	if err != nil {
-		return nil, err
+		return cosmosclient.Context{}, err
 	}
 
 	// Note should cosmos nodes start exposing grpc, its preferable
 	// to connect directly with grpc.Dial to avoid using clientCtx (according to tendermint team).
 	// If so then we would start putting timeouts on the ctx we pass in to the generate grpc client calls.
-	clientCtx := params.NewClientContext().
+	return params.NewClientContext().
 		WithAccountRetriever(authtypes.AccountRetriever{}).
 		WithClient(tmClient).
-		WithChainID(chainID)
-
-	cosmosServiceClient := txtypes.NewServiceClient(clientCtx)
-	authClient := authtypes.NewQueryClient(clientCtx)
-	wasmClient := wasmtypes.NewQueryClient(clientCtx)
-	tendermintServiceClient := tmtypes.NewServiceClient(clientCtx)
-	bankClient := banktypes.NewQueryClient(clientCtx)
-
-	return &Client{
-		chainID:                 chainID,
-		cosmosServiceClient:     cosmosServiceClient,
-		authClient:              authClient,
-		wasmClient:              wasmClient,
-		tendermintServiceClient: tendermintServiceClient,
-		bankClient:              bankClient,
-		clientCtx:               clientCtx,
-		log:                     lggr,
-	}, nil
+		WithChainID(chainID), nil
+}
+
+// atHeight returns a context which pins queries to the state as of the block at height.
+func atHeight(height int64) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+}
+
+// retry calls fn until it succeeds, fails with a non-transient error, or runs out of attempts.
+// The returned error is classified, see ClassifyError.
+func retry[T any](c *Client, name string, fn func() (T, error)) (T, error) {
+	b := backoff.Backoff{Min: c.retryCfg.MinBackoff, Max: c.retryCfg.MaxBackoff, Jitter: true}
+	for attempt := 1; ; attempt++ {
+		res, err := fn()
+		err = ClassifyError(err)
+		if err == nil || attempt >= c.retryCfg.Attempts || !IsTransient(err) {
+			return res, err
+		}
+		d := b.Duration()
+		c.log.Debugw("Retrying request after transient error", "request", name, "attempt", attempt, "backoff", d, "err", err)
+		time.Sleep(d)
+	}
 }
 
 func (c *Client) Context() *cosmosclient.Context {
@@ -146,7 +305,18 @@ func (c *Client) Context() *cosmosclient.Context {
 // Account read the account address for the account number and sequence number.
 // !!Note only one sequence number can be used per account per block!!
 func (c *Client) Account(addr sdk.AccAddress) (uint64, uint64, error) {
-	r, err := c.authClient.Account(context.Background(), &authtypes.QueryAccountRequest{Address: addr.String()})
+	return c.account(context.Background(), c.authClient, addr)
+}
+
+// AccountAt reads the account number and sequence number as of the block at height.
+func (c *Client) AccountAt(addr sdk.AccAddress, height int64) (uint64, uint64, error) {
+	return c.account(atHeight(height), c.archiveAuthClient, addr)
+}
+
+func (c *Client) account(ctx context.Context, authClient authtypes.QueryClient, addr sdk.AccAddress) (uint64, uint64, error) {
+	r, err := retry(c, "Account", func() (*authtypes.QueryAccountResponse, error) {
+		return authClient.Account(ctx, &authtypes.QueryAccountRequest{Address: c.bech32.Address(addr)})
+	})
 	if err != nil {
 		return 0, 0, err
 	}
@@ -160,9 +330,20 @@ func (c *Client) Account(addr sdk.AccAddress) (uint64, uint64, error) {
 
 // ContractState reads from a WASM contract store
 func (c *Client) ContractState(contractAddress sdk.AccAddress, queryMsg []byte) ([]byte, error) {
-	s, err := c.wasmClient.SmartContractState(context.Background(), &wasmtypes.QuerySmartContractStateRequest{
-		Address:   contractAddress.String(),
-		QueryData: queryMsg,
+	return c.contractState(context.Background(), c.wasmClient, contractAddress, queryMsg)
+}
+
+// ContractStateAt reads from a WASM contract store as of the block at height
+func (c *Client) ContractStateAt(contractAddress sdk.AccAddress, queryMsg []byte, height int64) ([]byte, error) {
+	return c.contractState(atHeight(height), c.archiveWasmClient, contractAddress, queryMsg)
+}
+
+func (c *Client) contractState(ctx context.Context, wasmClient wasmtypes.QueryClient, contractAddress sdk.AccAddress, queryMsg []byte) ([]byte, error) {
+	s, err := retry(c, "ContractState", func() (*wasmtypes.QuerySmartContractStateResponse, error) {
+		return wasmClient.SmartContractState(ctx, &wasmtypes.QuerySmartContractStateRequest{
+			Address:   c.bech32.Address(contractAddress),
+			QueryData: queryMsg,
+		})
 	})
 	if err != nil {
 		return nil, err
@@ -171,39 +352,93 @@ func (c *Client) ContractState(contractAddress sdk.AccAddress, queryMsg []byte)
 	return s.Data, err
 }
 
+// RawContractState reads a raw key from a WASM contract store
+func (c *Client) RawContractState(contractAddress sdk.AccAddress, key []byte) ([]byte, error) {
+	s, err := retry(c, "RawContractState", func() (*wasmtypes.QueryRawContractStateResponse, error) {
+		return c.wasmClient.RawContractState(context.Background(), &wasmtypes.QueryRawContractStateRequest{
+			Address:   c.bech32.Address(contractAddress),
+			QueryData: key,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.Data, nil
+}
+
 // TxsEvents returns in tx events in descending order (latest txes first).
 // Each event is ANDed together and follows the query language defined
 // https://docs.cosmos.network/master/core/events.html
 // Note one current issue https://github.com/cosmos/cosmos-sdk/issues/10448
 func (c *Client) TxsEvents(events []string, paginationParams *query.PageRequest) (*txtypes.GetTxsEventResponse, error) {
-	e, err := c.cosmosServiceClient.GetTxsEvent(context.Background(), &txtypes.GetTxsEventRequest{
-		Events:     events,
-		Pagination: paginationParams,
-		OrderBy:    txtypes.OrderBy_ORDER_BY_DESC,
+	return retry(c, "TxsEvents", func() (*txtypes.GetTxsEventResponse, error) {
+		return c.cosmosServiceClient.GetTxsEvent(context.Background(), &txtypes.GetTxsEventRequest{
+			Events:     events,
+			Pagination: paginationParams,
+			OrderBy:    txtypes.OrderBy_ORDER_BY_DESC,
+		})
+	})
+}
+
+// TxsEventsPage returns a page of txs matching events.
+func (c *Client) TxsEventsPage(events []string, orderBy txtypes.OrderBy, page, limit uint64) (*txtypes.GetTxsEventResponse, error) {
+	return retry(c, "TxsEventsPage", func() (*txtypes.GetTxsEventResponse, error) {
+		return c.cosmosServiceClient.GetTxsEvent(context.Background(), &txtypes.GetTxsEventRequest{
+			Events:  events,
+			OrderBy: orderBy,
+			Page:    page,
+			Limit:   limit,
+		})
 	})
-	return e, err
 }
 
-// Tx gets a tx by hash
+// Tx gets a tx by hash.
+// ErrNotFound is returned if the tx is not (yet) included in a block.
 func (c *Client) Tx(hash string) (*txtypes.GetTxResponse, error) {
-	e, err := c.cosmosServiceClient.GetTx(context.Background(), &txtypes.GetTxRequest{
-		Hash: hash,
+	return retry(c, "Tx", func() (*txtypes.GetTxResponse, error) {
+		return c.cosmosServiceClient.GetTx(context.Background(), &txtypes.GetTxRequest{
+			Hash: hash,
+		})
 	})
-	return e, err
 }
 
 // LatestBlock returns the latest block
 func (c *Client) LatestBlock() (*tmtypes.GetLatestBlockResponse, error) {
-	return c.tendermintServiceClient.GetLatestBlock(context.Background(), &tmtypes.GetLatestBlockRequest{})
+	return retry(c, "LatestBlock", func() (*tmtypes.GetLatestBlockResponse, error) {
+		return c.tendermintServiceClient.GetLatestBlock(context.Background(), &tmtypes.GetLatestBlockRequest{})
+	})
+}
+
+// Status returns the node status
+func (c *Client) Status() (*coretypes.ResultStatus, error) {
+	return retry(c, "Status", func() (*coretypes.ResultStatus, error) {
+		return c.clientCtx.Client.Status(context.Background())
+	})
 }
 
 // BlockByHeight gets a block by height
 func (c *Client) BlockByHeight(height int64) (*tmtypes.GetBlockByHeightResponse, error) {
-	return c.tendermintServiceClient.GetBlockByHeight(context.Background(), &tmtypes.GetBlockByHeightRequest{Height: height})
+	return retry(c, "BlockByHeight", func() (*tmtypes.GetBlockByHeightResponse, error) {
+		return c.tendermintServiceClient.GetBlockByHeight(context.Background(), &tmtypes.GetBlockByHeightRequest{Height: height})
+	})
 }
 
 // CreateAndSign creates and signs a transaction
 func (c *Client) CreateAndSign(msgs []sdk.Msg, account uint64, sequence uint64, gasLimit uint64, gasLimitMultiplier float64, gasPrice sdk.DecCoin, signer cryptotypes.PrivKey, timeoutHeight uint64) ([]byte, error) {
+	return SignTx(c.chainID, msgs, account, sequence, gasLimit, gasLimitMultiplier, gasPrice, signer, timeoutHeight)
+}
+
+// TxFee returns the fee paid by a tx signed with gasLimit, gasLimitMultiplier and gasPrice.
+func TxFee(gasLimit uint64, gasLimitMultiplier float64, gasPrice sdk.DecCoin) sdk.Coin {
+	return sdk.NewCoin(gasPrice.Denom, gasPrice.Amount.MulInt64(int64(bufferedGasLimit(gasLimit, gasLimitMultiplier))).Ceil().RoundInt())
+}
+
+func bufferedGasLimit(gasLimit uint64, gasLimitMultiplier float64) uint64 {
+	return uint64(math.Ceil(float64(gasLimit) * gasLimitMultiplier))
+}
+
+// SignTx creates and signs a transaction for chainID, without reaching a node.
+func SignTx(chainID string, msgs []sdk.Msg, account uint64, sequence uint64, gasLimit uint64, gasLimitMultiplier float64, gasPrice sdk.DecCoin, signer cryptotypes.PrivKey, timeoutHeight uint64) ([]byte, error) {
 	// https://github.com/cosmos/cosmos-sdk/blob/a785bf5af602525cf7a5c5ea097056597e2eb7ef/client/tx/tx.go#L63-L117
 	// https://docs.cosmos.network/main/run-node/txs#signing-a-transaction-1
 	txConfig := params.ClientTxConfig()
@@ -212,10 +447,8 @@ func (c *Client) CreateAndSign(msgs []sdk.Msg, account uint64, sequence uint64,
 	if err != nil {
 		return nil, err
 	}
-	gasLimitBuffered := uint64(math.Ceil(float64(gasLimit) * float64(gasLimitMultiplier)))
-	txBuilder.SetGasLimit(gasLimitBuffered)
-	gasFee := sdk.NewCoin(gasPrice.Denom, gasPrice.Amount.MulInt64(int64(gasLimitBuffered)).Ceil().RoundInt())
-	txBuilder.SetFeeAmount(sdk.NewCoins(gasFee))
+	txBuilder.SetGasLimit(bufferedGasLimit(gasLimit, gasLimitMultiplier))
+	txBuilder.SetFeeAmount(sdk.NewCoins(TxFee(gasLimit, gasLimitMultiplier, gasPrice)))
 	// 0 timeout height means unset.
 	txBuilder.SetTimeoutHeight(timeoutHeight)
 
@@ -229,7 +462,7 @@ func (c *Client) CreateAndSign(msgs []sdk.Msg, account uint64, sequence uint64,
 
 	signerData := authsigning.SignerData{
 		AccountNumber: account,
-		ChainID:       c.chainID,
+		ChainID:       chainID,
 		Sequence:      sequence,
 	}
 
@@ -309,24 +542,6 @@ type BatchSimResults struct {
 	Succeeded SimMsgs
 }
 
-var failedMsgIndexRe = regexp.MustCompile(`^.*failed to execute message; message index: (?P<Index>\d+):.*$`)
-
-func (c *Client) failedMsgIndex(err error) (bool, int) {
-	if err == nil {
-		return false, 0
-	}
-
-	m := failedMsgIndexRe.FindStringSubmatch(err.Error())
-	if len(m) != 2 {
-		return false, 0
-	}
-	index, err := strconv.ParseInt(m[1], 10, 32)
-	if err != nil {
-		return false, 0
-	}
-	return true, int(index)
-}
-
 // BatchSimulateUnsigned simulates a group of msgs.
 // Assumes at least one msg is present.
 // If we fail to simulate the batch, remove the offending tx
@@ -341,7 +556,7 @@ func (c *Client) BatchSimulateUnsigned(msgs SimMsgs, sequence uint64) (*BatchSim
 	toSim := msgs
 	for {
 		_, err := c.SimulateUnsigned(toSim.GetMsgs(), sequence)
-		containsFailure, failureIndex := c.failedMsgIndex(err)
+		failureIndex, containsFailure := FailedMsgIndex(err)
 		if err != nil && !containsFailure {
 			return nil, err
 		}
@@ -393,34 +608,70 @@ func (c *Client) SimulateUnsigned(msgs []sdk.Msg, sequence uint64) (*txtypes.Sim
 	if err != nil {
 		return nil, err
 	}
-	s, err := c.cosmosServiceClient.Simulate(context.Background(), &txtypes.SimulateRequest{
-		TxBytes: txBytes,
-	})
-	return s, err
+	return c.Simulate(txBytes)
 }
 
 // Simulate simulates a signed transaction
 func (c *Client) Simulate(txBytes []byte) (*txtypes.SimulateResponse, error) {
-	s, err := c.cosmosServiceClient.Simulate(context.Background(), &txtypes.SimulateRequest{
-		TxBytes: txBytes,
+	return retry(c, "Simulate", func() (*txtypes.SimulateResponse, error) {
+		return c.cosmosServiceClient.Simulate(context.Background(), &txtypes.SimulateRequest{
+			TxBytes: txBytes,
+		})
 	})
-	return s, err
 }
 
-// Broadcast broadcasts a tx
+// Broadcast broadcasts a tx.
+// If the tx is rejected, the response is returned along with an *Error classifying the rejection.
+// With several broadcast clients, the tx is broadcast to all of them in parallel, and the first accepted response is
+// returned. A node which already has the tx in its mempool cache accepts it, since it got it from another node.
+// Otherwise the errors of all nodes are returned.
 func (c *Client) Broadcast(txBytes []byte, mode txtypes.BroadcastMode) (*txtypes.BroadcastTxResponse, error) {
+	switch len(c.broadcasters) {
+	case 0:
+		return c.broadcast(txBytes, mode)
+	case 1:
+		return c.broadcasters[0].broadcast(txBytes, mode)
+	}
+	type result struct {
+		res *txtypes.BroadcastTxResponse
+		err error
+	}
+	// buffered, so that the broadcasts still in flight do not block once a response is accepted
+	results := make(chan result, len(c.broadcasters))
+	for _, b := range c.broadcasters {
+		go func(b *Client) {
+			res, err := b.broadcast(txBytes, mode)
+			if err != nil && !errors.Is(err, ErrTxInMempoolCache) {
+				b.log.Debugw("Node rejected broadcast", "err", err)
+			}
+			results <- result{res, err}
+		}(b)
+	}
+	var errs []error
+	for range c.broadcasters {
+		r := <-results
+		if r.err == nil || (errors.Is(r.err, ErrTxInMempoolCache) && r.res != nil) {
+			return r.res, nil
+		}
+		errs = append(errs, r.err)
+	}
+	return nil, errors.Join(errs...)
+}
+
+func (c *Client) broadcast(txBytes []byte, mode txtypes.BroadcastMode) (*txtypes.BroadcastTxResponse, error) {
 	res, err := c.cosmosServiceClient.BroadcastTx(context.Background(), &txtypes.BroadcastTxRequest{
 		Mode:    mode,
 		TxBytes: txBytes,
 	})
 	if err != nil {
-		return nil, err
+		return nil, ClassifyError(err)
 	}
 	if res.TxResponse == nil {
 		return nil, fmt.Errorf("got nil tx response")
 	}
 	if res.TxResponse.Code != 0 {
-		return res, fmt.Errorf("tx failed with error code: %d, resp %v", res.TxResponse.Code, res.TxResponse)
+		tx := res.TxResponse
+		return res, NewABCIError(tx.Codespace, tx.Code, tx.RawLog, fmt.Errorf("tx failed with error code: %d, resp %v", tx.Code, tx))
 	}
 	return res, err
 }
@@ -441,9 +692,53 @@ func (c *Client) SignAndBroadcast(msgs []sdk.Msg, account uint64, sequence uint6
 
 // Balance returns the balance of an address
 func (c *Client) Balance(addr sdk.AccAddress, denom string) (*sdk.Coin, error) {
-	b, err := c.bankClient.Balance(context.Background(), &banktypes.QueryBalanceRequest{Address: addr.String(), Denom: denom})
+	return c.balance(context.Background(), c.bankClient, addr, denom)
+}
+
+// BalanceAt returns the balance of an address as of the block at height
+func (c *Client) BalanceAt(addr sdk.AccAddress, denom string, height int64) (*sdk.Coin, error) {
+	return c.balance(atHeight(height), c.archiveBankClient, addr, denom)
+}
+
+func (c *Client) balance(ctx context.Context, bankClient banktypes.QueryClient, addr sdk.AccAddress, denom string) (*sdk.Coin, error) {
+	b, err := retry(c, "Balance", func() (*banktypes.QueryBalanceResponse, error) {
+		return bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: c.bech32.Address(addr), Denom: denom})
+	})
 	if err != nil {
 		return nil, err
 	}
 	return b.Balance, nil
 }
+
+// AddressString returns addr as encoded by the auth module of the node, with the bech32 prefix of the chain.
+func (c *Client) AddressString(addr sdk.AccAddress) (string, error) {
+	res, err := retry(c, "AddressString", func() (*authtypes.AddressBytesToStringResponse, error) {
+		return c.authClient.AddressBytesToString(context.Background(), &authtypes.AddressBytesToStringRequest{AddressBytes: addr})
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.AddressString, nil
+}
+
+// DenomMetadata returns the metadata of denom registered in the bank module.
+func (c *Client) DenomMetadata(denom string) (*banktypes.Metadata, error) {
+	res, err := retry(c, "DenomMetadata", func() (*banktypes.QueryDenomMetadataResponse, error) {
+		return c.bankClient.DenomMetadata(context.Background(), &banktypes.QueryDenomMetadataRequest{Denom: denom})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res.Metadata, nil
+}
+
+// SupplyOf returns the total supply of denom.
+func (c *Client) SupplyOf(denom string) (*sdk.Coin, error) {
+	res, err := retry(c, "SupplyOf", func() (*banktypes.QuerySupplyOfResponse, error) {
+		return c.bankClient.SupplyOf(context.Background(), &banktypes.QuerySupplyOfRequest{Denom: denom})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res.Amount, nil
+}
@@ -4,9 +4,13 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	dbm "github.com/cometbft/cometbft-db"
 	"github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/light"
+	dbs "github.com/cometbft/cometbft/light/store/db"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
@@ -18,6 +22,7 @@ import (
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 )
 
 func TestMain(m *testing.M) {
@@ -173,6 +178,9 @@ func TestCosmosClient(t *testing.T) {
 		b, err = tc.Balance(accounts[1].Address, "ucosm")
 		require.NoError(t, err)
 		assert.Equal(t, "100000001", b.Amount.String())
+		b, err = tc.BalanceAt(accounts[1].Address, "ucosm", tx.TxResponse.Height-1)
+		require.NoError(t, err)
+		assert.Equal(t, "100000000", b.Amount.String())
 
 		// Invalid tx should error
 		_, err = tc.Tx("1234")
@@ -252,6 +260,28 @@ func TestCosmosClient(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, `{"count":4}`, string(count))
 
+		// Batched queries are answered in order
+		results, err := tc.BatchContractState([]ContractQuery{
+			{ContractAddress: contract, QueryMsg: []byte(`{"get_count":{}}`)},
+			{ContractAddress: contract, QueryMsg: []byte(`{"blah":{}}`)},
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		require.NoError(t, results[0].Err)
+		assert.Equal(t, `{"count":4}`, string(results[0].Data))
+		require.Error(t, results[1].Err)
+
+		// Historical queries see the state as of each execution
+		count, err = tc.ContractStateAt(contract, []byte(`{"get_count":{}}`), tx1.TxResponse.Height)
+		require.NoError(t, err)
+		assert.Equal(t, `{"count":5}`, string(count))
+		count, err = tc.ContractStateAt(contract, []byte(`{"get_count":{}}`), tx1.TxResponse.Height-1)
+		require.NoError(t, err)
+		assert.Equal(t, `{"count":0}`, string(count))
+		_, snAt, err := tc.AccountAt(accounts[0].Address, tx1.TxResponse.Height)
+		require.NoError(t, err)
+		assert.Equal(t, sn, snAt)
+
 		// Check events querying works
 		// TxEvents sorts in a descending manner, so latest txes are first
 		ev, err := tc.TxsEvents([]string{"wasm.action='reset'", fmt.Sprintf("wasm._contract_address='%s'", contract.String())}, nil)
@@ -296,6 +326,33 @@ func TestCosmosClient(t *testing.T) {
 				}
 			}
 		}
+
+		// Verify raw contract state with proofs against light client verified headers
+		first := int64(1)
+		commit, err := tc.Context().Client.Commit(tests.Context(t), &first)
+		require.NoError(t, err)
+		lc, err := light.NewHTTPClient(tests.Context(t), "42", light.TrustOptions{
+			Period: time.Hour,
+			Height: 1,
+			Hash:   commit.SignedHeader.Hash(),
+		}, tendermintURL, []string{tendermintURL}, dbs.New(dbm.NewMemDB(), ""))
+		require.NoError(t, err)
+		verified := NewVerifiedStateReader(tc.Context().Client, lc)
+		for _, tt := range []struct {
+			height int64
+			count  int
+		}{{tx1.TxResponse.Height, 5}, {tx2.TxResponse.Height, 4}} {
+			// the header of the next block must exist
+			var state []byte
+			require.Eventually(t, func() bool {
+				state, err = verified.RawContractState(tests.Context(t), contract, []byte("state"), tt.height)
+				return err == nil
+			}, tests.WaitTimeout(t), time.Second)
+			assert.Contains(t, string(state), fmt.Sprintf(`"count":%d`, tt.count))
+		}
+		state, err := verified.RawContractState(tests.Context(t), contract, []byte("blah"), tx2.TxResponse.Height)
+		require.NoError(t, err)
+		assert.Nil(t, state)
 	})
 
 	t.Run("gasprice", func(t *testing.T) {
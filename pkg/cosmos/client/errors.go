@@ -0,0 +1,166 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"regexp"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Error kinds returned by Client, to be matched with errors.Is.
+var (
+	ErrNotFound         = errors.New("not found")
+	ErrSequenceMismatch = errors.New("account sequence mismatch")
+	ErrInsufficientFee  = errors.New("insufficient fee")
+	ErrMempoolFull      = errors.New("mempool is full")
+	// ErrTxInMempoolCache is returned when a node has already seen the tx, e.g. from a broadcast to another node.
+	ErrTxInMempoolCache = errors.New("tx already in mempool cache")
+	ErrOutOfGas         = errors.New("out of gas")
+	// ErrExecutionFailed is returned when a msg fails to execute, e.g. a contract error. See FailedMsgIndex.
+	ErrExecutionFailed = errors.New("msg execution failed")
+	// ErrTransient is returned for transport errors which are expected to go away when retried.
+	ErrTransient = errors.New("transient transport error")
+)
+
+// Error is an error classified by its ABCI codespace and code.
+type Error struct {
+	// Kind is one of the Err* kinds above, or nil if the error is not one of them.
+	Kind error
+	// Codespace and Code are the ABCI error codespace and code, if known.
+	Codespace string
+	Code      uint32
+	// MsgIndex is the index of the msg which failed to execute, or -1 if unknown.
+	MsgIndex int
+	Err      error
+}
+
+func (e *Error) Error() string { return e.Err.Error() }
+
+func (e *Error) Unwrap() []error {
+	if e.Kind == nil {
+		return []error{e.Err}
+	}
+	return []error{e.Kind, e.Err}
+}
+
+// abciKinds maps ABCI errors to kinds.
+var abciKinds = []struct {
+	abciErr *errorsmod.Error
+	kind    error
+}{
+	{wasmtypes.ErrExecuteFailed, ErrExecutionFailed},
+	{sdkerrors.ErrOutOfGas, ErrOutOfGas},
+	{sdkerrors.ErrWrongSequence, ErrSequenceMismatch},
+	{sdkerrors.ErrInsufficientFee, ErrInsufficientFee},
+	{sdkerrors.ErrMempoolIsFull, ErrMempoolFull},
+	{sdkerrors.ErrTxInMempoolCache, ErrTxInMempoolCache},
+	{sdkerrors.ErrKeyNotFound, ErrNotFound},
+	{sdkerrors.ErrNotFound, ErrNotFound},
+}
+
+// failedMsgIndexRe matches the msg index which baseapp adds to msg execution errors.
+// It is only available from the error log, which is why we still need to parse it.
+var failedMsgIndexRe = regexp.MustCompile(`^.*failed to execute message; message index: (?P<Index>\d+):.*$`)
+
+// abciCodeRe matches the codespace and code which SDK errors embed in the message of their gRPC status.
+var abciCodeRe = regexp.MustCompile(`codespace (\S+) code (\d+):`)
+
+// abciKind returns the kind of the ABCI error with codespace and code, or nil if it is not one of them.
+func abciKind(codespace string, code uint32) error {
+	for _, k := range abciKinds {
+		if k.abciErr.Codespace() == codespace && k.abciErr.ABCICode() == code {
+			return k.kind
+		}
+	}
+	return nil
+}
+
+func failedMsgIndex(log string) int {
+	m := failedMsgIndexRe.FindStringSubmatch(log)
+	if len(m) != 2 {
+		return -1
+	}
+	index, err := strconv.ParseInt(m[1], 10, 32)
+	if err != nil {
+		return -1
+	}
+	return int(index)
+}
+
+// NewABCIError returns err classified by the ABCI codespace, code and log of a failed tx or query.
+func NewABCIError(codespace string, code uint32, log string, err error) *Error {
+	e := &Error{Codespace: codespace, Code: code, Kind: abciKind(codespace, code), MsgIndex: failedMsgIndex(log), Err: err}
+	if e.Kind == nil && e.MsgIndex >= 0 {
+		e.Kind = ErrExecutionFailed
+	}
+	return e
+}
+
+// ClassifyError returns err wrapped in an *Error if it can be classified, or err otherwise.
+// Query errors only keep their ABCI codespace and code in the message of their gRPC status when served by the
+// gRPC server of a node, so others are classified by their gRPC status code alone. Simulation errors keep neither.
+func ClassifyError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var e *Error
+	if errors.As(err, &e) {
+		return err
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) || errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return &Error{Kind: ErrTransient, MsgIndex: -1, Err: err}
+	}
+	s, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	switch s.Code() {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return &Error{Kind: ErrTransient, MsgIndex: -1, Err: err}
+	}
+	e = &Error{MsgIndex: failedMsgIndex(s.Message()), Err: err}
+	if m := abciCodeRe.FindStringSubmatch(s.Message()); m != nil {
+		if code, err := strconv.ParseUint(m[2], 10, 32); err == nil {
+			e.Codespace, e.Code = m[1], uint32(code)
+			e.Kind = abciKind(e.Codespace, e.Code)
+		}
+	}
+	if e.Kind == nil && s.Code() == codes.NotFound {
+		e.Kind = ErrNotFound
+	}
+	if e.Kind == nil && e.MsgIndex >= 0 {
+		e.Kind = ErrExecutionFailed
+	}
+	if e.Kind == nil {
+		return err
+	}
+	return e
+}
+
+// FailedMsgIndex returns the index of the msg which failed to execute, if err contains it.
+func FailedMsgIndex(err error) (int, bool) {
+	if err == nil {
+		return 0, false
+	}
+	index := failedMsgIndex(err.Error())
+	var e *Error
+	if errors.As(ClassifyError(err), &e) {
+		index = e.MsgIndex
+	}
+	return index, index >= 0
+}
+
+// IsTransient returns true if err is expected to go away when the request is retried.
+func IsTransient(err error) bool {
+	return errors.Is(ClassifyError(err), ErrTransient)
+}
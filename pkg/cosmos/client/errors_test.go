@@ -0,0 +1,118 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+func TestClassifyError(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		err      error
+		kind     error
+		msgIndex int
+	}{
+		{"nil", nil, nil, -1},
+		{"unclassified", errors.New("boom"), nil, -1},
+		{"connection refused", fmt.Errorf("post failed: %w", &url.Error{Op: "Post", URL: "http://localhost", Err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}}), ErrTransient, -1},
+		{"unavailable", status.Error(codes.Unavailable, "connection reset"), ErrTransient, -1},
+		{"grpc not found", status.Error(codes.NotFound, "account wasm1xyz not found"), ErrNotFound, -1},
+		{"tx not found", status.Error(codes.NotFound, "tx not found: ABCD"), ErrNotFound, -1},
+		{"sequence", status.Error(codes.Unknown, "codespace sdk code 32: incorrect account sequence: account sequence mismatch, expected 5, got 4"), ErrSequenceMismatch, -1},
+		{"out of gas", status.Error(codes.Unknown, "codespace sdk code 11: out of gas: out of gas in location: WriteFlat; gasWanted: 10, gasUsed: 11"), ErrOutOfGas, -1},
+		{"key not found", status.Error(codes.NotFound, "codespace sdk code 22: key not found: account wasm1xyz"), ErrNotFound, -1},
+		{"descriptions", status.Error(codes.Unknown, "query wasm contract failed: price feed not found: out of gas"), nil, -1},
+		{"contract query", status.Error(codes.InvalidArgument, "codespace wasm code 9: query wasm contract failed: price feed not found: invalid request"), nil, -1},
+		{"contract", status.Error(codes.InvalidArgument, "failed to execute message; message index: 3: Error parsing into type my_first_contract::msg::ExecuteMsg: unknown variant `blah`: execute wasm contract failed: invalid request"), ErrExecutionFailed, 3},
+		{"msg", status.Error(codes.Unknown, "failed to execute message; message index: 1: 10ucosm is smaller than 20ucosm: insufficient funds"), ErrExecutionFailed, 1},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ClassifyError(tt.err)
+			if tt.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.err)
+			var e *Error
+			if tt.kind == nil {
+				assert.False(t, errors.As(err, &e))
+				return
+			}
+			require.ErrorIs(t, err, tt.kind)
+			require.ErrorAs(t, err, &e)
+			assert.Equal(t, tt.msgIndex, e.MsgIndex)
+			assert.Equal(t, tt.kind == ErrTransient, IsTransient(err))
+			index, ok := FailedMsgIndex(err)
+			assert.Equal(t, tt.msgIndex >= 0, ok)
+			if ok {
+				assert.Equal(t, tt.msgIndex, index)
+			}
+		})
+	}
+}
+
+func TestNewABCIError(t *testing.T) {
+	for _, tt := range []struct {
+		codespace string
+		code      uint32
+		kind      error
+	}{
+		{"sdk", 32, ErrSequenceMismatch},
+		{"sdk", 13, ErrInsufficientFee},
+		{"sdk", 20, ErrMempoolFull},
+		{"sdk", 19, ErrTxInMempoolCache},
+		{"sdk", 11, ErrOutOfGas},
+		{"wasm", 5, ErrExecutionFailed},
+		{"sdk", 4, nil},
+	} {
+		t.Run(fmt.Sprintf("%s/%d", tt.codespace, tt.code), func(t *testing.T) {
+			err := NewABCIError(tt.codespace, tt.code, "log", errors.New("tx failed"))
+			assert.Equal(t, tt.kind, err.Kind)
+			assert.Equal(t, -1, err.MsgIndex)
+			if tt.kind != nil {
+				assert.ErrorIs(t, err, tt.kind)
+			}
+		})
+	}
+}
+
+func TestRetry(t *testing.T) {
+	c := &Client{retryCfg: RetryConfig{Attempts: 3}, log: logger.Test(t)}
+	var calls int
+	_, err := retry(c, "test", func() (int, error) {
+		calls++
+		return 0, status.Error(codes.Unavailable, "unavailable")
+	})
+	require.ErrorIs(t, err, ErrTransient)
+	assert.Equal(t, 3, calls)
+
+	calls = 0
+	_, err = retry(c, "test", func() (int, error) {
+		calls++
+		return 0, status.Error(codes.NotFound, "not found")
+	})
+	require.ErrorIs(t, err, ErrNotFound)
+	assert.Equal(t, 1, calls)
+
+	calls = 0
+	res, err := retry(c, "test", func() (int, error) {
+		calls++
+		if calls < 2 {
+			return 0, status.Error(codes.Unavailable, "unavailable")
+		}
+		return 42, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 42, res)
+	assert.Equal(t, 2, calls)
+}
@@ -1,14 +1,19 @@
 package client
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
+	"slices"
+	"sync"
+	"time"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/fee"
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"go.uber.org/multierr"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 type GasPricesEstimator interface {
@@ -84,53 +89,347 @@ func (gpe *ClosureGasPriceEstimator) GasPrices() (map[string]sdk.DecCoin, error)
 	return gpe.gasPrices()
 }
 
-var _ GasPricesEstimator = (*CachingGasPriceEstimator)(nil)
+// GasPriceStage is an estimator of a ComposedGasPriceEstimator.
+type GasPriceStage struct {
+	Estimator GasPricesEstimator
+	// Weight of the prices of Estimator in the weighted average of all stages.
+	// A stage with zero weight is a fallback, used only when no weighted stage has a price for a denom.
+	Weight sdk.Dec
+	// MaxAge is how long the last good prices of Estimator are used for when it fails. Zero disables reuse.
+	MaxAge time.Duration
+}
 
-type CachingGasPriceEstimator struct {
-	lastPrices map[string]sdk.DecCoin
-	estimator  GasPricesEstimator
-	lggr       logger.Logger
+// lastGoodPrices are the last prices returned by a stage, and when.
+type lastGoodPrices struct {
+	prices map[string]sdk.DecCoin
+	at     time.Time
 }
 
-func NewCachingGasPriceEstimator(estimator GasPricesEstimator, lggr logger.Logger) *CachingGasPriceEstimator {
-	return &CachingGasPriceEstimator{estimator: estimator, lggr: lggr}
+var _ GasPricesEstimator = (*ComposedGasPriceEstimator)(nil)
+
+// ComposedGasPriceEstimator combines the prices of its stages into a weighted average per denom,
+// falling back to unweighted stages in order. It is safe for concurrent use.
+type ComposedGasPriceEstimator struct {
+	stages []GasPriceStage
+	lggr   logger.Logger
+
+	mu       sync.Mutex
+	lastGood []lastGoodPrices
 }
 
-func (gpe *CachingGasPriceEstimator) GasPrices() (map[string]sdk.DecCoin, error) {
-	latestPrices, err := gpe.estimator.GasPrices()
-	if err != nil {
-		if gpe.lastPrices == nil {
-			return nil, fmt.Errorf("unable to get gas prices and cache is empty: %w", err)
+// NewComposedGasPriceEstimator returns an estimator combining stages, see GasPriceStage.
+func NewComposedGasPriceEstimator(stages []GasPriceStage, lggr logger.Logger) *ComposedGasPriceEstimator {
+	for i := range stages {
+		if stages[i].Weight.IsNil() {
+			stages[i].Weight = sdk.ZeroDec()
 		}
-		gpe.lggr.Warnf("error %v getting latest prices, using cached value %v", err, gpe.lastPrices)
-		return gpe.lastPrices, nil
 	}
-	gpe.lastPrices = latestPrices
-	return latestPrices, nil
+	return &ComposedGasPriceEstimator{stages: stages, lggr: lggr, lastGood: make([]lastGoodPrices, len(stages))}
 }
 
-type ComposedGasPriceEstimator struct {
-	estimators []GasPricesEstimator
-	lggr       logger.Logger
+// GasPrices returns the combined prices of all stages, or an error if no stage has any prices.
+func (gpe *ComposedGasPriceEstimator) GasPrices() (map[string]sdk.DecCoin, error) {
+	gpe.mu.Lock()
+	defer gpe.mu.Unlock()
+	var errs error
+	results := make([]map[string]sdk.DecCoin, len(gpe.stages))
+	for i, stage := range gpe.stages {
+		prices, err := stage.Estimator.GasPrices()
+		if err == nil {
+			gpe.lastGood[i] = lastGoodPrices{prices: prices, at: time.Now()}
+			results[i] = prices
+			continue
+		}
+		errs = multierr.Append(errs, fmt.Errorf("estimator %d: %w", i, err))
+		if last := gpe.lastGood[i]; last.prices != nil && time.Since(last.at) < stage.MaxAge {
+			gpe.lggr.Warnw("Error using estimator, using its last good prices", "estimator", i, "err", err, "prices", last.prices, "age", time.Since(last.at))
+			results[i] = last.prices
+			continue
+		}
+		gpe.lggr.Warnw("Error using estimator, skipping it", "estimator", i, "err", err)
+	}
+
+	weighted := map[string]sdk.Dec{}
+	totalWeights := map[string]sdk.Dec{}
+	fallbacks := map[string]sdk.DecCoin{}
+	for i, prices := range results {
+		weight := gpe.stages[i].Weight
+		for denom, price := range prices {
+			if !weight.IsPositive() {
+				if _, ok := fallbacks[denom]; !ok {
+					fallbacks[denom] = price
+				}
+				continue
+			}
+			if _, ok := weighted[denom]; !ok {
+				weighted[denom], totalWeights[denom] = sdk.ZeroDec(), sdk.ZeroDec()
+			}
+			weighted[denom] = weighted[denom].Add(price.Amount.Mul(weight))
+			totalWeights[denom] = totalWeights[denom].Add(weight)
+		}
+	}
+	prices := fallbacks
+	for denom, sum := range weighted {
+		prices[denom] = sdk.NewDecCoinFromDec(denom, sum.Quo(totalWeights[denom]))
+	}
+	if len(prices) == 0 {
+		if errs == nil {
+			return nil, errors.New("no estimator returned any prices")
+		}
+		return nil, fmt.Errorf("no estimator succeeded: %w", errs)
+	}
+	return prices, nil
+}
+
+// Sources of dynamic gas prices, see DynamicGasPriceEstimator.
+const (
+	// GasPriceSourceFeeMarket is the gas price of the Skip x/feemarket module.
+	GasPriceSourceFeeMarket = "feemarket"
+	// GasPriceSourceEIP1559 is the EIP-1559 base fee of the Osmosis x/txfees module, which is in the chain's base denom.
+	GasPriceSourceEIP1559 = "eip1559"
+	// GasPriceSourceMinimumGasPrices is the minimum-gas-prices of the node.
+	GasPriceSourceMinimumGasPrices = "minimum-gas-prices"
+)
+
+// GasPriceSources are the supported sources of dynamic gas prices.
+var GasPriceSources = []string{GasPriceSourceFeeMarket, GasPriceSourceEIP1559, GasPriceSourceMinimumGasPrices}
+
+// DynamicGasPriceConfig configures a DynamicGasPriceEstimator.
+type DynamicGasPriceConfig struct {
+	// Source is one of GasPriceSources.
+	Source string
+	Denom  string
+	// Multiplier is applied to the price from Source, before it is bounded by Floor and Ceiling.
+	Multiplier sdk.Dec
+	// Floor and Ceiling bound the estimated price. A zero Ceiling means no upper bound.
+	Floor   sdk.Dec
+	Ceiling sdk.Dec
 }
 
-func NewMustGasPriceEstimator(estimators []GasPricesEstimator, lggr logger.Logger) *ComposedGasPriceEstimator {
-	return &ComposedGasPriceEstimator{estimators: estimators, lggr: lggr}
+var _ GasPricesEstimator = (*DynamicGasPriceEstimator)(nil)
+
+// DynamicGasPriceEstimator estimates the gas price of a denom from the chain's dynamic fees.
+type DynamicGasPriceEstimator struct {
+	reader func() (Reader, error)
+	cfg    DynamicGasPriceConfig
+	lggr   logger.Logger
 }
 
-func (gpe *ComposedGasPriceEstimator) GasPrices() map[string]sdk.DecCoin {
-	// Try each estimator in order
-	var finalError error
-	for _, estimator := range gpe.estimators {
-		latestPrices, err := estimator.GasPrices()
+func NewDynamicGasPriceEstimator(reader func() (Reader, error), cfg DynamicGasPriceConfig, lggr logger.Logger) (*DynamicGasPriceEstimator, error) {
+	if !slices.Contains(GasPriceSources, cfg.Source) {
+		return nil, fmt.Errorf("unknown gas price source %q, expected one of %v", cfg.Source, GasPriceSources)
+	}
+	if cfg.Multiplier.IsNil() {
+		cfg.Multiplier = sdk.OneDec()
+	}
+	if cfg.Floor.IsNil() {
+		cfg.Floor = sdk.ZeroDec()
+	}
+	if cfg.Ceiling.IsNil() {
+		cfg.Ceiling = sdk.ZeroDec()
+	}
+	return &DynamicGasPriceEstimator{reader: reader, cfg: cfg, lggr: lggr}, nil
+}
+
+func (gpe *DynamicGasPriceEstimator) GasPrices() (map[string]sdk.DecCoin, error) {
+	reader, err := gpe.reader()
+	if err != nil {
+		return nil, err
+	}
+	price, err := gpe.price(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gas price from %s: %w", gpe.cfg.Source, err)
+	}
+	estimate := price.Mul(gpe.cfg.Multiplier)
+	if estimate.LT(gpe.cfg.Floor) {
+		estimate = gpe.cfg.Floor
+	}
+	if gpe.cfg.Ceiling.IsPositive() && estimate.GT(gpe.cfg.Ceiling) {
+		gpe.lggr.Warnw("Dynamic gas price exceeds ceiling", "source", gpe.cfg.Source, "price", price, "ceiling", gpe.cfg.Ceiling)
+		estimate = gpe.cfg.Ceiling
+	}
+	return map[string]sdk.DecCoin{gpe.cfg.Denom: sdk.NewDecCoinFromDec(gpe.cfg.Denom, estimate)}, nil
+}
+
+func (gpe *DynamicGasPriceEstimator) price(reader Reader) (sdk.Dec, error) {
+	switch gpe.cfg.Source {
+	case GasPriceSourceFeeMarket:
+		price, err := reader.FeeMarketGasPrice(gpe.cfg.Denom)
 		if err != nil {
-			finalError = multierr.Combine(finalError, err)
-			gpe.lggr.Warnf("error using estimator, trying next one, err %v", err)
+			return sdk.Dec{}, err
+		}
+		if price.Denom != gpe.cfg.Denom {
+			return sdk.Dec{}, fmt.Errorf("got gas price in %s instead of %s", price.Denom, gpe.cfg.Denom)
+		}
+		return price.Amount, nil
+	case GasPriceSourceEIP1559:
+		return reader.EIP1559BaseFee()
+	case GasPriceSourceMinimumGasPrices:
+		prices, err := reader.MinimumGasPrices()
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		// a node without a minimum price for denom accepts any price, so the floor applies
+		return prices.AmountOf(gpe.cfg.Denom), nil
+	}
+	return sdk.Dec{}, fmt.Errorf("unknown gas price source %q", gpe.cfg.Source)
+}
+
+// PercentileGasPriceConfig configures a PercentileGasPriceEstimator.
+type PercentileGasPriceConfig struct {
+	// Blocks is the number of recent blocks to sample.
+	Blocks int64
+	// Percentile of the sampled fees per gas to estimate, from 0 to 100.
+	Percentile int64
+	// TTL is how long an estimate is reused before sampling again.
+	TTL time.Duration
+	// Denom, if set, must have been sampled for an estimate to succeed.
+	Denom string
+	// Floor and Ceiling bound the estimated price of Denom. A zero Ceiling means no upper bound.
+	Floor   sdk.Dec
+	Ceiling sdk.Dec
+}
+
+var _ GasPricesEstimator = (*PercentileGasPriceEstimator)(nil)
+
+// PercentileGasPriceEstimator estimates gas prices per denom from the fees per gas paid by txs in recent blocks.
+// It suits chains without a fee market module.
+type PercentileGasPriceEstimator struct {
+	reader func() (Reader, error)
+	cfg    PercentileGasPriceConfig
+	lggr   logger.Logger
+
+	mu      sync.Mutex
+	samples map[int64]map[string][]sdk.Dec // fees per gas by denom, of each sampled block
+	prices  map[string]sdk.DecCoin
+	expires time.Time
+}
+
+func NewPercentileGasPriceEstimator(reader func() (Reader, error), cfg PercentileGasPriceConfig, lggr logger.Logger) (*PercentileGasPriceEstimator, error) {
+	if cfg.Blocks <= 0 {
+		return nil, fmt.Errorf("blocks must be positive: %d", cfg.Blocks)
+	}
+	if cfg.Percentile < 0 || cfg.Percentile > 100 {
+		return nil, fmt.Errorf("percentile must be between 0 and 100: %d", cfg.Percentile)
+	}
+	if cfg.Floor.IsNil() {
+		cfg.Floor = sdk.ZeroDec()
+	}
+	if cfg.Ceiling.IsNil() {
+		cfg.Ceiling = sdk.ZeroDec()
+	}
+	return &PercentileGasPriceEstimator{reader: reader, cfg: cfg, lggr: lggr, samples: map[int64]map[string][]sdk.Dec{}}, nil
+}
+
+func (gpe *PercentileGasPriceEstimator) GasPrices() (map[string]sdk.DecCoin, error) {
+	gpe.mu.Lock()
+	defer gpe.mu.Unlock()
+	if gpe.prices != nil && time.Now().Before(gpe.expires) {
+		return gpe.prices, nil
+	}
+	reader, err := gpe.reader()
+	if err != nil {
+		return nil, err
+	}
+	if err = gpe.sample(reader); err != nil {
+		return nil, fmt.Errorf("failed to sample fees of recent blocks: %w", err)
+	}
+	byDenom := map[string][]sdk.Dec{}
+	for _, fees := range gpe.samples {
+		for denom, prices := range fees {
+			byDenom[denom] = append(byDenom[denom], prices...)
+		}
+	}
+	prices := make(map[string]sdk.DecCoin, len(byDenom))
+	for denom, samples := range byDenom {
+		prices[denom] = sdk.NewDecCoinFromDec(denom, percentile(samples, gpe.cfg.Percentile))
+	}
+	if denom := gpe.cfg.Denom; denom != "" {
+		price, ok := prices[denom]
+		if !ok {
+			return nil, fmt.Errorf("no fees paid in %s in the last %d blocks", denom, gpe.cfg.Blocks)
+		}
+		if price.Amount.LT(gpe.cfg.Floor) {
+			price.Amount = gpe.cfg.Floor
+		}
+		if gpe.cfg.Ceiling.IsPositive() && price.Amount.GT(gpe.cfg.Ceiling) {
+			gpe.lggr.Warnw("Percentile gas price exceeds ceiling", "price", price, "ceiling", gpe.cfg.Ceiling)
+			price.Amount = gpe.cfg.Ceiling
+		}
+		prices[denom] = price
+	}
+	gpe.prices, gpe.expires = prices, time.Now().Add(gpe.cfg.TTL)
+	return prices, nil
+}
+
+// sample fetches the fees of recent blocks which have not been sampled yet, and forgets older blocks.
+func (gpe *PercentileGasPriceEstimator) sample(reader Reader) error {
+	status, err := reader.Status()
+	if err != nil {
+		return err
+	}
+	latest := status.SyncInfo.LatestBlockHeight
+	oldest := max(latest-gpe.cfg.Blocks+1, 1)
+	for height := range gpe.samples {
+		if height < oldest || height > latest {
+			delete(gpe.samples, height)
+		}
+	}
+	for height := oldest; height <= latest; height++ {
+		if _, ok := gpe.samples[height]; ok {
 			continue
 		}
-		return latestPrices
+		res, err := reader.BlockByHeight(height)
+		if err != nil {
+			return fmt.Errorf("failed to get block %d: %w", height, err)
+		}
+		var txs [][]byte
+		if res.SdkBlock != nil {
+			txs = res.SdkBlock.Data.Txs
+		} else if res.Block != nil { //nolint:staticcheck // nodes before v0.47 only return Block
+			txs = res.Block.Data.Txs //nolint:staticcheck
+		}
+		fees := map[string][]sdk.Dec{}
+		for _, tx := range txs {
+			fee, err := decodeFee(tx)
+			if err != nil {
+				gpe.lggr.Debugw("Skipping undecodable tx", "height", height, "err", err)
+				continue
+			}
+			if fee.GasLimit == 0 {
+				continue
+			}
+			for _, coin := range fee.Amount {
+				fees[coin.Denom] = append(fees[coin.Denom], sdk.NewDecFromInt(coin.Amount).QuoInt64(int64(fee.GasLimit)))
+			}
+		}
+		gpe.samples[height] = fees
+	}
+	return nil
+}
+
+// decodeFee decodes only the fee of an encoded tx, so that txs with unregistered msg types are sampled too.
+func decodeFee(txBytes []byte) (*txtypes.Fee, error) {
+	var raw txtypes.TxRaw
+	if err := raw.Unmarshal(txBytes); err != nil {
+		return nil, err
+	}
+	var authInfo txtypes.AuthInfo
+	if err := authInfo.Unmarshal(raw.AuthInfoBytes); err != nil {
+		return nil, err
+	}
+	if authInfo.Fee == nil {
+		return nil, errors.New("missing fee")
 	}
-	panic(fmt.Sprintf("no estimator succeeded errs %v", finalError))
+	return authInfo.Fee, nil
+}
+
+// percentile returns the nearest-rank percentile p of samples, which must not be empty.
+func percentile(samples []sdk.Dec, p int64) sdk.Dec {
+	sorted := slices.Clone(samples)
+	slices.SortFunc(sorted, func(a, b sdk.Dec) int { return a.BigInt().Cmp(b.BigInt()) })
+	rank := (p*int64(len(sorted)) + 99) / 100 // ceil(p/100 * n)
+	return sorted[max(rank-1, 0)]
 }
 
 func FormatGasPrice(gasPrice *big.Int) string {
@@ -3,15 +3,22 @@ package client
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 
 	"go.uber.org/zap"
 
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
 )
 
 func TestGasPriceEstimators(t *testing.T) {
@@ -33,33 +40,6 @@ func TestGasPriceEstimators(t *testing.T) {
 		assert.Equal(t, "10.000000000000000000", price.Amount.String())
 	})
 
-	t.Run("caching", func(t *testing.T) {
-		responses := []sdk.DecCoin{
-			sdk.NewDecCoinFromDec("ucosm", sdk.MustNewDecFromStr("10")),
-		}
-		gpe := NewClosureGasPriceEstimator(func() (map[string]sdk.DecCoin, error) {
-			if len(responses) == 0 {
-				return nil, errors.New("no more prices")
-			}
-			var price sdk.DecCoin
-			price, responses = responses[0], responses[1:]
-			return map[string]sdk.DecCoin{
-				"ucosm": price,
-			}, nil
-		})
-		cachedGpe := NewCachingGasPriceEstimator(gpe, lggr)
-
-		// Fill cache
-		prices, err := cachedGpe.GasPrices()
-		require.NoError(t, err)
-
-		// Use cache, no more prices returned from estimator
-		t.Cleanup(assertLogsLen(t, 1))
-		cachedPrices, err := cachedGpe.GasPrices()
-		require.NoError(t, err)
-		assert.Equal(t, prices["ucosm"], cachedPrices["ucosm"])
-	})
-
 	t.Run("closure", func(t *testing.T) {
 		gpe := NewClosureGasPriceEstimator(func() (map[string]sdk.DecCoin, error) {
 			return map[string]sdk.DecCoin{
@@ -86,22 +66,70 @@ func TestGasPriceEstimators(t *testing.T) {
 				"ucosm": price,
 			}, nil
 		})
-		cachingGpe := NewCachingGasPriceEstimator(closureGpe, lggr)
 		gpeFixed := NewFixedGasPriceEstimator(map[string]sdk.DecCoin{
 			"ucosm": sdk.NewDecCoinFromDec("ucosm", sdk.MustNewDecFromStr("10")),
 		}, sugaredLggr)
-		gpe := NewMustGasPriceEstimator([]GasPricesEstimator{cachingGpe, gpeFixed}, lggr)
-		t.Cleanup(assertLogsLen(t, 1))
-		fixedPrices := gpe.GasPrices()
+		gpe := NewComposedGasPriceEstimator([]GasPriceStage{
+			{Estimator: closureGpe, Weight: sdk.OneDec(), MaxAge: time.Hour},
+			{Estimator: gpeFixed},
+		}, lggr)
+		t.Cleanup(assertLogsLen(t, 2))
+		fixedPrices, err := gpe.GasPrices()
+		require.NoError(t, err)
 		ucosm, ok := fixedPrices["ucosm"]
 		assert.True(t, ok)
 		assert.Equal(t, "10.000000000000000000", ucosm.Amount.String())
 		// If the url starts working, it should use that.
 		responses = append(responses, sdk.NewDecCoinFromDec("ucosm", sdk.MustNewDecFromStr("9")))
-		gpePrices := gpe.GasPrices()
+		gpePrices, err := gpe.GasPrices()
+		require.NoError(t, err)
 		ucosm, ok = gpePrices["ucosm"]
 		assert.True(t, ok)
-		assert.NotEqual(t, "10.000000000000000000", ucosm.Amount.String())
+		assert.Equal(t, "9.000000000000000000", ucosm.Amount.String())
+		// Then its last good price is used when it fails again.
+		gpePrices, err = gpe.GasPrices()
+		require.NoError(t, err)
+		assert.Equal(t, "9.000000000000000000", gpePrices["ucosm"].Amount.String())
+	})
+
+	t.Run("weighted", func(t *testing.T) {
+		fixed := func(prices ...sdk.DecCoin) GasPricesEstimator {
+			m := map[string]sdk.DecCoin{}
+			for _, p := range prices {
+				m[p.Denom] = p
+			}
+			return NewFixedGasPriceEstimator(m, sugaredLggr)
+		}
+		gpe := NewComposedGasPriceEstimator([]GasPriceStage{
+			{Estimator: fixed(sdk.NewDecCoinFromDec("ucosm", sdk.MustNewDecFromStr("1"))), Weight: sdk.NewDec(3)},
+			{Estimator: fixed(sdk.NewDecCoinFromDec("ucosm", sdk.MustNewDecFromStr("5")), sdk.NewDecCoinFromDec("uatom", sdk.MustNewDecFromStr("2"))), Weight: sdk.NewDec(1)},
+			{Estimator: fixed(sdk.NewDecCoinFromDec("ucosm", sdk.MustNewDecFromStr("100")), sdk.NewDecCoinFromDec("uosmo", sdk.MustNewDecFromStr("7")))},
+		}, lggr)
+		prices, err := gpe.GasPrices()
+		require.NoError(t, err)
+		assert.Equal(t, map[string]sdk.DecCoin{
+			"ucosm": sdk.NewDecCoinFromDec("ucosm", sdk.MustNewDecFromStr("2")),
+			"uatom": sdk.NewDecCoinFromDec("uatom", sdk.MustNewDecFromStr("2")),
+			"uosmo": sdk.NewDecCoinFromDec("uosmo", sdk.MustNewDecFromStr("7")),
+		}, prices)
+	})
+
+	t.Run("all failing", func(t *testing.T) {
+		var fail bool
+		closureGpe := NewClosureGasPriceEstimator(func() (map[string]sdk.DecCoin, error) {
+			if fail {
+				return nil, errors.New("no prices")
+			}
+			return map[string]sdk.DecCoin{"ucosm": sdk.NewDecCoinFromDec("ucosm", sdk.MustNewDecFromStr("1"))}, nil
+		})
+		gpe := NewComposedGasPriceEstimator([]GasPriceStage{{Estimator: closureGpe, Weight: sdk.OneDec()}}, lggr)
+		t.Cleanup(assertLogsLen(t, 1))
+		_, err := gpe.GasPrices()
+		require.NoError(t, err)
+		// the last good price is not reused without a MaxAge
+		fail = true
+		_, err = gpe.GasPrices()
+		require.ErrorContains(t, err, "no estimator succeeded: estimator 0: no prices")
 	})
 }
 
@@ -157,3 +185,173 @@ func TestFixedPriceGasEstimator(t *testing.T) {
 		}
 	})
 }
+
+// feeReader serves the dynamic fee queries of a Reader.
+type feeReader struct {
+	Reader
+	feeMarketPrice   sdk.DecCoin
+	baseFee          sdk.Dec
+	minimumGasPrices sdk.DecCoins
+}
+
+func (r *feeReader) FeeMarketGasPrice(denom string) (sdk.DecCoin, error) {
+	return r.feeMarketPrice, nil
+}
+
+func (r *feeReader) EIP1559BaseFee() (sdk.Dec, error) {
+	return r.baseFee, nil
+}
+
+func (r *feeReader) MinimumGasPrices() (sdk.DecCoins, error) {
+	return r.minimumGasPrices, nil
+}
+
+func TestDynamicGasPriceEstimator(t *testing.T) {
+	reader := &feeReader{
+		feeMarketPrice:   sdk.NewDecCoinFromDec("ucosm", sdk.MustNewDecFromStr("0.02")),
+		baseFee:          sdk.MustNewDecFromStr("0.0025"),
+		minimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("ucosm", sdk.MustNewDecFromStr("0.1"))),
+	}
+	for _, tt := range []struct {
+		name string
+		cfg  DynamicGasPriceConfig
+		exp  string
+	}{
+		{"feemarket", DynamicGasPriceConfig{Source: GasPriceSourceFeeMarket, Multiplier: sdk.MustNewDecFromStr("1.5")}, "0.03"},
+		{"eip1559 floor", DynamicGasPriceConfig{Source: GasPriceSourceEIP1559, Floor: sdk.MustNewDecFromStr("0.01")}, "0.01"},
+		{"minimum-gas-prices ceiling", DynamicGasPriceConfig{Source: GasPriceSourceMinimumGasPrices, Ceiling: sdk.MustNewDecFromStr("0.05")}, "0.05"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cfg.Denom = "ucosm"
+			gpe, err := NewDynamicGasPriceEstimator(func() (Reader, error) { return reader, nil }, tt.cfg, logger.Test(t))
+			require.NoError(t, err)
+			prices, err := gpe.GasPrices()
+			require.NoError(t, err)
+			assert.Equal(t, sdk.NewDecCoinFromDec("ucosm", sdk.MustNewDecFromStr(tt.exp)), prices["ucosm"])
+		})
+	}
+
+	t.Run("wrong denom", func(t *testing.T) {
+		gpe, err := NewDynamicGasPriceEstimator(func() (Reader, error) { return reader, nil }, DynamicGasPriceConfig{Source: GasPriceSourceFeeMarket, Denom: "uatom"}, logger.Test(t))
+		require.NoError(t, err)
+		_, err = gpe.GasPrices()
+		require.ErrorContains(t, err, "got gas price in ucosm instead of uatom")
+	})
+
+	_, err := NewDynamicGasPriceEstimator(nil, DynamicGasPriceConfig{Source: "oracle"}, logger.Test(t))
+	require.ErrorContains(t, err, `unknown gas price source "oracle"`)
+}
+
+func TestProtoBytesField(t *testing.T) {
+	price := sdk.NewDecCoinFromDec("ucosm", sdk.MustNewDecFromStr("0.025"))
+	b, err := price.Marshal()
+	require.NoError(t, err)
+	// QueryGasPriceResponse{price=1}, preceded by an unknown varint field
+	msg := protowire.AppendVarint(protowire.AppendTag(nil, 2, protowire.VarintType), 7)
+	msg = protowire.AppendBytes(protowire.AppendTag(msg, 1, protowire.BytesType), b)
+	got, err := protoBytesField(msg, 1)
+	require.NoError(t, err)
+	assert.Equal(t, b, got)
+
+	_, err = protoBytesField([]byte{0x0a, 0x05}, 1)
+	require.Error(t, err)
+}
+
+// blockReader serves blocks of txs paying the given gas prices, and counts the blocks fetched.
+type blockReader struct {
+	Reader
+	t       *testing.T
+	blocks  [][]sdk.DecCoin
+	fetched int
+}
+
+func (r *blockReader) Status() (*coretypes.ResultStatus, error) {
+	return &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: int64(len(r.blocks))}}, nil
+}
+
+func (r *blockReader) BlockByHeight(height int64) (*tmtypes.GetBlockByHeightResponse, error) {
+	r.fetched++
+	key := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(key.PubKey().Address())
+	var data cmtproto.Data
+	for i, price := range r.blocks[height-1] {
+		send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("ucosm", 1)))
+		tx, err := SignTx("chain", []sdk.Msg{send}, 1, uint64(i), 100_000, 1, price, key, 0)
+		require.NoError(r.t, err)
+		data.Txs = append(data.Txs, tx)
+	}
+	// a tx which does not decode is skipped
+	data.Txs = append(data.Txs, []byte("not a tx"))
+	return &tmtypes.GetBlockByHeightResponse{SdkBlock: &tmtypes.Block{Data: data}}, nil
+}
+
+func TestPercentileGasPriceEstimator(t *testing.T) {
+	price := func(denom, amount string) sdk.DecCoin {
+		return sdk.NewDecCoinFromDec(denom, sdk.MustNewDecFromStr(amount))
+	}
+	reader := &blockReader{t: t, blocks: [][]sdk.DecCoin{
+		{price("ucosm", "0.01"), price("ucosm", "0.05"), price("uatom", "0.2")},
+		{price("ucosm", "0.02"), price("ucosm", "0.04")},
+		{price("ucosm", "0.03")},
+	}}
+	newEstimator := func(cfg PercentileGasPriceConfig) *PercentileGasPriceEstimator {
+		gpe, err := NewPercentileGasPriceEstimator(func() (Reader, error) { return reader, nil }, cfg, logger.Test(t))
+		require.NoError(t, err)
+		return gpe
+	}
+
+	t.Run("percentile per denom", func(t *testing.T) {
+		reader.fetched = 0
+		gpe := newEstimator(PercentileGasPriceConfig{Blocks: 3, Percentile: 60, TTL: time.Hour})
+		prices, err := gpe.GasPrices()
+		require.NoError(t, err)
+		assert.Equal(t, map[string]sdk.DecCoin{
+			"ucosm": price("ucosm", "0.03"),
+			"uatom": price("uatom", "0.2"),
+		}, prices)
+		assert.Equal(t, 3, reader.fetched)
+
+		// cached until the TTL expires
+		_, err = gpe.GasPrices()
+		require.NoError(t, err)
+		assert.Equal(t, 3, reader.fetched)
+	})
+
+	t.Run("new blocks", func(t *testing.T) {
+		reader.fetched = 0
+		gpe := newEstimator(PercentileGasPriceConfig{Blocks: 2, Percentile: 100})
+		prices, err := gpe.GasPrices()
+		require.NoError(t, err)
+		assert.Equal(t, price("ucosm", "0.04"), prices["ucosm"])
+		assert.Equal(t, 2, reader.fetched)
+
+		// only the new block is fetched, and the oldest is forgotten
+		reader.blocks = append(reader.blocks, []sdk.DecCoin{price("ucosm", "0.001")})
+		defer func() { reader.blocks = reader.blocks[:3] }()
+		prices, err = gpe.GasPrices()
+		require.NoError(t, err)
+		assert.Equal(t, price("ucosm", "0.03"), prices["ucosm"])
+		assert.Equal(t, 3, reader.fetched)
+	})
+
+	t.Run("bounds", func(t *testing.T) {
+		gpe := newEstimator(PercentileGasPriceConfig{Blocks: 3, Percentile: 0, Denom: "ucosm", Floor: sdk.MustNewDecFromStr("0.015")})
+		prices, err := gpe.GasPrices()
+		require.NoError(t, err)
+		assert.Equal(t, price("ucosm", "0.015"), prices["ucosm"])
+
+		gpe = newEstimator(PercentileGasPriceConfig{Blocks: 3, Percentile: 100, Denom: "ucosm", Ceiling: sdk.MustNewDecFromStr("0.045")})
+		prices, err = gpe.GasPrices()
+		require.NoError(t, err)
+		assert.Equal(t, price("ucosm", "0.045"), prices["ucosm"])
+	})
+
+	t.Run("missing denom", func(t *testing.T) {
+		gpe := newEstimator(PercentileGasPriceConfig{Blocks: 1, Percentile: 50, Denom: "uatom"})
+		_, err := gpe.GasPrices()
+		require.ErrorContains(t, err, "no fees paid in uatom in the last 1 blocks")
+	})
+
+	_, err := NewPercentileGasPriceEstimator(nil, PercentileGasPriceConfig{Blocks: 1, Percentile: 101}, logger.Test(t))
+	require.ErrorContains(t, err, "percentile must be between 0 and 100")
+}
@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// ABCI query paths of the fee modules. Neither module is a dependency, so their messages are encoded by hand.
+const (
+	// feeMarketGasPricePath is the Skip x/feemarket GasPrice query, taking a QueryGasPriceRequest{denom=1}
+	// and returning a QueryGasPriceResponse{price=1}.
+	feeMarketGasPricePath = "/feemarket.feemarket.v1.Query/GasPrice"
+	// eip1559BaseFeePath is the Osmosis x/txfees GetEipBaseFee query, taking an empty request
+	// and returning a QueryEipBaseFeeResponse{base_fee=1}.
+	eip1559BaseFeePath = "/osmosis.txfees.v1beta1.Query/GetEipBaseFee"
+)
+
+// FeeMarketGasPrice returns the current gas price of denom from the x/feemarket module.
+func (c *Client) FeeMarketGasPrice(denom string) (sdk.DecCoin, error) {
+	req := protowire.AppendTag(nil, 1, protowire.BytesType)
+	req = protowire.AppendString(req, denom)
+	res, err := c.abciQuery("FeeMarketGasPrice", feeMarketGasPricePath, req)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+	b, err := protoBytesField(res, 1)
+	if err != nil {
+		return sdk.DecCoin{}, fmt.Errorf("failed to decode gas price response: %w", err)
+	}
+	var price sdk.DecCoin
+	if err := price.Unmarshal(b); err != nil {
+		return sdk.DecCoin{}, fmt.Errorf("failed to decode gas price: %w", err)
+	}
+	return price, nil
+}
+
+// EIP1559BaseFee returns the current base fee per gas from the Osmosis x/txfees module, in the chain's base denom.
+func (c *Client) EIP1559BaseFee() (sdk.Dec, error) {
+	res, err := c.abciQuery("EIP1559BaseFee", eip1559BaseFeePath, nil)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	b, err := protoBytesField(res, 1)
+	if err != nil {
+		return sdk.Dec{}, fmt.Errorf("failed to decode base fee response: %w", err)
+	}
+	var fee sdk.Dec
+	if err := fee.Unmarshal(b); err != nil {
+		return sdk.Dec{}, fmt.Errorf("failed to decode base fee %q: %w", b, err)
+	}
+	return fee, nil
+}
+
+// MinimumGasPrices returns the minimum-gas-prices the node accepts txs with.
+func (c *Client) MinimumGasPrices() (sdk.DecCoins, error) {
+	res, err := retry(c, "MinimumGasPrices", func() (*node.ConfigResponse, error) {
+		return node.NewServiceClient(c.clientCtx).Config(context.Background(), &node.ConfigRequest{})
+	})
+	if err != nil {
+		return nil, err
+	}
+	prices, err := sdk.ParseDecCoins(res.MinimumGasPrice)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse minimum gas prices %q: %w", res.MinimumGasPrice, err)
+	}
+	return prices, nil
+}
+
+// abciQuery sends a raw ABCI query, and returns the response value.
+func (c *Client) abciQuery(name, path string, req []byte) ([]byte, error) {
+	return retry(c, name, func() ([]byte, error) {
+		res, err := c.clientCtx.Client.ABCIQuery(context.Background(), path, req)
+		if err != nil {
+			return nil, err
+		}
+		resp := res.Response
+		if !resp.IsOK() {
+			return nil, NewABCIError(resp.Codespace, resp.Code, resp.Log, fmt.Errorf("query %s failed with code %d: %s", path, resp.Code, resp.Log))
+		}
+		return resp.Value, nil
+	})
+}
+
+// protoBytesField returns the value of the first length-delimited field num in the encoded msg, or nil if it is missing.
+func protoBytesField(msg []byte, num protowire.Number) ([]byte, error) {
+	for len(msg) > 0 {
+		n, typ, l := protowire.ConsumeTag(msg)
+		if l < 0 {
+			return nil, protowire.ParseError(l)
+		}
+		msg = msg[l:]
+		if n == num && typ == protowire.BytesType {
+			v, l := protowire.ConsumeBytes(msg)
+			if l < 0 {
+				return nil, protowire.ParseError(l)
+			}
+			return v, nil
+		}
+		l = protowire.ConsumeFieldValue(n, typ, msg)
+		if l < 0 {
+			return nil, protowire.ParseError(l)
+		}
+		msg = msg[l:]
+	}
+	return nil, nil
+}
@@ -0,0 +1,172 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jpillora/backoff"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+)
+
+// Head is the latest block seen on a chain.
+type Head struct {
+	Height int64
+	Time   time.Time
+}
+
+// HeadReader provides the latest head of a chain.
+type HeadReader interface {
+	// LatestHead returns the latest head, which is at most maxAge old.
+	LatestHead(ctx context.Context) (Head, error)
+}
+
+var _ HeadReader = (*HeadTracker)(nil)
+
+// HeadTracker keeps track of the latest head of a chain, so that callers share a single
+// source of truth rather than each fetching the latest block.
+// Heads are received from a new block header subscription. Should the subscription fall
+// behind by more than maxAge, LatestHead falls back to querying the node status.
+// If a verifier is set, heads are only accepted once their header has been verified, e.g. by a LightClient.
+type HeadTracker struct {
+	services.StateMachine
+	reader   func() (Reader, error)
+	verifier LightBlockVerifier
+	maxAge   time.Duration
+	lggr     logger.Logger
+
+	mu       sync.RWMutex
+	head     Head
+	received time.Time // local time at which head was received
+
+	stop services.StopChan
+	wg   sync.WaitGroup
+}
+
+// NewHeadTracker returns a HeadTracker which reads from the clients returned by reader.
+// verifier is optional, and heads are trusted as served by the nodes if nil.
+func NewHeadTracker(reader func() (Reader, error), verifier LightBlockVerifier, maxAge time.Duration, lggr logger.Logger) *HeadTracker {
+	return &HeadTracker{
+		reader:   reader,
+		verifier: verifier,
+		maxAge:   maxAge,
+		lggr:     logger.Named(lggr, "HeadTracker"),
+		stop:     make(services.StopChan),
+	}
+}
+
+func (ht *HeadTracker) Name() string { return ht.lggr.Name() }
+
+func (ht *HeadTracker) Start(context.Context) error {
+	return ht.StartOnce("HeadTracker", func() error {
+		ht.wg.Add(1)
+		go ht.run()
+		return nil
+	})
+}
+
+func (ht *HeadTracker) Close() error {
+	return ht.StopOnce("HeadTracker", func() error {
+		close(ht.stop)
+		ht.wg.Wait()
+		return nil
+	})
+}
+
+func (ht *HeadTracker) HealthReport() map[string]error {
+	return map[string]error{ht.Name(): ht.Healthy()}
+}
+
+// LatestHead returns the latest head, querying the node status if the latest head received is older than maxAge.
+func (ht *HeadTracker) LatestHead(ctx context.Context) (Head, error) {
+	ht.mu.RLock()
+	head, received := ht.head, ht.received
+	ht.mu.RUnlock()
+	if !received.IsZero() && time.Since(received) < ht.maxAge {
+		return head, nil
+	}
+	reader, err := ht.reader()
+	if err != nil {
+		return Head{}, fmt.Errorf("failed to get client: %w", err)
+	}
+	status, err := reader.Status()
+	if err != nil {
+		return Head{}, fmt.Errorf("failed to get status: %w", err)
+	}
+	head, err = ht.verify(ctx, Head{Height: status.SyncInfo.LatestBlockHeight, Time: status.SyncInfo.LatestBlockTime})
+	if err != nil {
+		return Head{}, err
+	}
+	ht.update(head)
+	return head, nil
+}
+
+// verify returns head as verified by the verifier, if set.
+func (ht *HeadTracker) verify(ctx context.Context, head Head) (Head, error) {
+	if ht.verifier == nil {
+		return head, nil
+	}
+	block, err := ht.verifier.VerifyLightBlockAtHeight(ctx, head.Height, time.Now())
+	if err != nil {
+		return Head{}, fmt.Errorf("failed to verify header at height %d: %w", head.Height, err)
+	}
+	return Head{Height: block.Height, Time: block.Time}, nil
+}
+
+// update sets the latest head, unless it is older than the current one.
+func (ht *HeadTracker) update(head Head) {
+	ht.mu.Lock()
+	defer ht.mu.Unlock()
+	if head.Height < ht.head.Height {
+		return
+	}
+	ht.head, ht.received = head, time.Now()
+}
+
+func (ht *HeadTracker) run() {
+	defer ht.wg.Done()
+	ctx, cancel := ht.stop.NewCtx()
+	defer cancel()
+	b := backoff.Backoff{Min: time.Second, Max: time.Minute, Jitter: true}
+	for {
+		err := ht.subscribe(ctx, &b)
+		if ctx.Err() != nil {
+			return
+		}
+		ht.lggr.Errorw("Failed to subscribe to new heads, falling back to status until resubscribed", "err", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(b.Duration()):
+		}
+	}
+}
+
+// subscribe updates the latest head from new block headers, until ctx is done or the subscription fails.
+func (ht *HeadTracker) subscribe(ctx context.Context, b *backoff.Backoff) error {
+	reader, err := ht.reader()
+	if err != nil {
+		return err
+	}
+	headers, err := reader.SubscribeNewBlockHeaders(ctx)
+	if err != nil {
+		return err
+	}
+	for header := range headers {
+		b.Reset()
+		head, err := ht.verify(ctx, Head{Height: header.Header.Height, Time: header.Header.Time})
+		if err != nil {
+			ht.lggr.Errorw("Ignoring unverified head", "height", header.Header.Height, "err", err)
+			continue
+		}
+		ht.update(head)
+	}
+	if ctx.Err() == nil {
+		return errors.New("subscription closed")
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+)
+
+// headsReader implements the Reader methods used by HeadTracker.
+type headsReader struct {
+	Reader
+	statusCalls atomic.Int32
+	height      atomic.Int64
+	headers     chan cmttypes.EventDataNewBlockHeader
+}
+
+func (r *headsReader) Status() (*coretypes.ResultStatus, error) {
+	r.statusCalls.Add(1)
+	return &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: r.height.Load()}}, nil
+}
+
+func (r *headsReader) SubscribeNewBlockHeaders(ctx context.Context) (<-chan cmttypes.EventDataNewBlockHeader, error) {
+	out := make(chan cmttypes.EventDataNewBlockHeader)
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case h := <-r.headers:
+				select {
+				case out <- h:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out, nil
+}
+
+// heightVerifier verifies headers up to a maximum height.
+type heightVerifier struct {
+	max int64
+}
+
+func (v *heightVerifier) VerifyLightBlockAtHeight(_ context.Context, height int64, _ time.Time) (*cmttypes.LightBlock, error) {
+	if height > v.max {
+		return nil, errors.New("not verified")
+	}
+	return &cmttypes.LightBlock{SignedHeader: &cmttypes.SignedHeader{Header: &cmttypes.Header{Height: height}}}, nil
+}
+
+func TestHeadTracker(t *testing.T) {
+	t.Run("status fallback", func(t *testing.T) {
+		r := &headsReader{}
+		r.height.Store(5)
+		ht := NewHeadTracker(func() (Reader, error) { return r, nil }, nil, time.Hour, logger.Test(t))
+
+		head, err := ht.LatestHead(tests.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), head.Height)
+
+		// cached
+		r.height.Store(6)
+		head, err = ht.LatestHead(tests.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), head.Height)
+		assert.Equal(t, int32(1), r.statusCalls.Load())
+	})
+
+	t.Run("stale", func(t *testing.T) {
+		r := &headsReader{}
+		r.height.Store(5)
+		ht := NewHeadTracker(func() (Reader, error) { return r, nil }, nil, 0, logger.Test(t))
+
+		_, err := ht.LatestHead(tests.Context(t))
+		require.NoError(t, err)
+		r.height.Store(6)
+		head, err := ht.LatestHead(tests.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, int64(6), head.Height)
+		assert.Equal(t, int32(2), r.statusCalls.Load())
+	})
+
+	t.Run("subscription", func(t *testing.T) {
+		r := &headsReader{headers: make(chan cmttypes.EventDataNewBlockHeader)}
+		ht := NewHeadTracker(func() (Reader, error) { return r, nil }, nil, time.Hour, logger.Test(t))
+		require.NoError(t, ht.Start(tests.Context(t)))
+		t.Cleanup(func() { require.NoError(t, ht.Close()) })
+
+		now := time.Now()
+		for _, height := range []int64{7, 8} {
+			select {
+			case r.headers <- cmttypes.EventDataNewBlockHeader{Header: cmttypes.Header{Height: height, Time: now}}:
+			case <-time.After(tests.WaitTimeout(t)):
+				t.Fatal("timed out sending header")
+			}
+		}
+		require.Eventually(t, func() bool {
+			head, err := ht.LatestHead(tests.Context(t))
+			return err == nil && head.Height == 8
+		}, tests.WaitTimeout(t), 10*time.Millisecond)
+		assert.Equal(t, int32(0), r.statusCalls.Load())
+	})
+	t.Run("verified", func(t *testing.T) {
+		r := &headsReader{headers: make(chan cmttypes.EventDataNewBlockHeader)}
+		r.height.Store(9)
+		ht := NewHeadTracker(func() (Reader, error) { return r, nil }, &heightVerifier{max: 7}, time.Hour, logger.Test(t))
+
+		_, err := ht.LatestHead(tests.Context(t))
+		require.ErrorContains(t, err, "failed to verify header at height 9")
+
+		require.NoError(t, ht.Start(tests.Context(t)))
+		t.Cleanup(func() { require.NoError(t, ht.Close()) })
+		for _, height := range []int64{7, 8} {
+			select {
+			case r.headers <- cmttypes.EventDataNewBlockHeader{Header: cmttypes.Header{Height: height, Time: time.Now()}}:
+			case <-time.After(tests.WaitTimeout(t)):
+				t.Fatal("timed out sending header")
+			}
+		}
+		require.Eventually(t, func() bool {
+			head, err := ht.LatestHead(tests.Context(t))
+			return err == nil && head.Height == 7
+		}, tests.WaitTimeout(t), 10*time.Millisecond)
+	})
+}
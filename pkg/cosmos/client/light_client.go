@@ -0,0 +1,267 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/light"
+	"github.com/cometbft/cometbft/light/provider"
+	httpprovider "github.com/cometbft/cometbft/light/provider/http"
+	dbs "github.com/cometbft/cometbft/light/store/db"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+)
+
+// DefaultLightClientTrustPeriod is a conservative trust period, well below the usual 21 day unbonding period.
+const DefaultLightClientTrustPeriod = 7 * 24 * time.Hour
+
+// LightClientTrust is the root of trust of a LightClient.
+type LightClientTrust struct {
+	// Height and Hash identify a header which is trusted without verification.
+	Height int64
+	Hash   []byte
+	// Period is how long headers remain trusted, which must be shorter than the unbonding period.
+	Period time.Duration
+}
+
+// LightClientTrustStore saves the latest verified header of a LightClient, so that restarts resume from it rather
+// than from the configured header, which expires after the trust period.
+type LightClientTrustStore interface {
+	// LoadTrustedHeader returns the saved header, or a zero height if none was saved.
+	LoadTrustedHeader(ctx context.Context) (height int64, hash []byte, err error)
+	// SaveTrustedHeader saves a verified header.
+	SaveTrustedHeader(ctx context.Context, height int64, hash []byte) error
+}
+
+// maxLightClientSaveInterval is how often the latest verified header is saved, unless the trust period is very short.
+const maxLightClientSaveInterval = time.Hour
+
+var _ LightBlockVerifier = (*LightClient)(nil)
+
+// LightClient verifies headers served by the configured nodes with a CometBFT light client, starting from a
+// trusted header. Every verified header is cross-checked against all nodes, and nodes serving a conflicting
+// header are flagged as unhealthy.
+// The light client is initialized on first use, since that requires reaching the nodes. It starts from the latest
+// header saved in the store, which is loaded on Start, or the configured trusted header if newer.
+type LightClient struct {
+	services.StateMachine
+	chainID string
+	trust   LightClientTrust
+	nodes   map[string]string     // name => tendermint URL
+	store   LightClientTrustStore // optional
+	lggr    logger.Logger
+
+	newProvider func(chainID, url string) (provider.Provider, error)
+
+	initMu    sync.Mutex
+	client    *light.Client
+	providers map[string]provider.Provider // name => provider
+
+	mu        sync.RWMutex
+	checked   int64            // highest height cross-checked against all nodes
+	conflicts map[string]int64 // name => height of conflicting header
+	initErr   error            // last error initializing the light client
+	saved     int64            // height of the last saved header
+	savedAt   time.Time
+}
+
+// NewLightClient returns a LightClient for chainID which verifies headers served by nodes, a map of node names to tendermint URLs.
+// store is optional, and the light client starts from the configured trusted header on every restart if nil.
+func NewLightClient(chainID string, trust LightClientTrust, nodes map[string]string, store LightClientTrustStore, lggr logger.Logger) *LightClient {
+	if trust.Period <= 0 {
+		trust.Period = DefaultLightClientTrustPeriod
+	}
+	return &LightClient{
+		chainID:     chainID,
+		trust:       trust,
+		nodes:       nodes,
+		store:       store,
+		lggr:        logger.Named(lggr, "LightClient"),
+		newProvider: httpprovider.New,
+		conflicts:   map[string]int64{},
+	}
+}
+
+func (lc *LightClient) Name() string { return lc.lggr.Name() }
+
+func (lc *LightClient) Start(ctx context.Context) error {
+	return lc.StartOnce("LightClient", func() error {
+		if len(lc.nodes) == 0 {
+			return errors.New("no nodes configured")
+		}
+		if len(lc.nodes) == 1 {
+			lc.lggr.Warn("Light client has a single node, which serves as both primary and witness, so its headers are not cross-checked against another node")
+		}
+		if lc.store == nil {
+			return nil
+		}
+		height, hash, err := lc.store.LoadTrustedHeader(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to load saved trusted header: %w", err)
+		}
+		if height > lc.trust.Height {
+			lc.lggr.Infow("Starting from saved trusted header", "height", height, "configuredHeight", lc.trust.Height)
+			lc.trust.Height, lc.trust.Hash = height, hash
+			lc.mu.Lock()
+			lc.saved = height
+			lc.mu.Unlock()
+		}
+		return nil
+	})
+}
+
+func (lc *LightClient) Close() error {
+	return lc.StopOnce("LightClient", func() error { return nil })
+}
+
+func (lc *LightClient) HealthReport() map[string]error {
+	err := lc.Healthy()
+	lc.mu.RLock()
+	if lc.initErr != nil {
+		err = multierr.Append(err, fmt.Errorf("failed to initialize light client: %w", lc.initErr))
+	}
+	lc.mu.RUnlock()
+	if conflicts := lc.ConflictingNodes(); len(conflicts) > 0 {
+		err = multierr.Append(err, fmt.Errorf("nodes serving conflicting headers: %v", conflicts))
+	}
+	return map[string]error{lc.Name(): err}
+}
+
+// ConflictingNodes returns the nodes which served a header conflicting with a verified one, along with its height.
+func (lc *LightClient) ConflictingNodes() map[string]int64 {
+	lc.mu.RLock()
+	defer lc.mu.RUnlock()
+	conflicts := make(map[string]int64, len(lc.conflicts))
+	for name, height := range lc.conflicts {
+		conflicts[name] = height
+	}
+	return conflicts
+}
+
+// VerifyLightBlockAtHeight returns the light block at height, once verified by the light client
+// and cross-checked against all nodes.
+func (lc *LightClient) VerifyLightBlockAtHeight(ctx context.Context, height int64, now time.Time) (*cmttypes.LightBlock, error) {
+	if err := lc.Ready(); err != nil {
+		return nil, err
+	}
+	client, err := lc.init(ctx)
+	lc.mu.Lock()
+	lc.initErr = err
+	lc.mu.Unlock()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize light client: %w", err)
+	}
+	block, err := client.VerifyLightBlockAtHeight(ctx, height, now)
+	if err != nil {
+		return nil, err
+	}
+	lc.crossCheck(ctx, block)
+	lc.save(ctx, block)
+	return block, nil
+}
+
+// save saves block to the store if it is newer than the last saved header, at most every maxLightClientSaveInterval.
+func (lc *LightClient) save(ctx context.Context, block *cmttypes.LightBlock) {
+	if lc.store == nil {
+		return
+	}
+	lc.mu.Lock()
+	if block.Height <= lc.saved || time.Since(lc.savedAt) < min(maxLightClientSaveInterval, lc.trust.Period/10) {
+		lc.mu.Unlock()
+		return
+	}
+	lc.saved, lc.savedAt = block.Height, time.Now()
+	lc.mu.Unlock()
+	if err := lc.store.SaveTrustedHeader(ctx, block.Height, block.Hash()); err != nil {
+		lc.lggr.Warnw("Failed to save trusted header", "height", block.Height, "err", err)
+	}
+}
+
+// init returns the light client, initializing it from the trusted header if necessary.
+// The node listed first by name is the primary, and the others are witnesses.
+func (lc *LightClient) init(ctx context.Context) (*light.Client, error) {
+	lc.initMu.Lock()
+	defer lc.initMu.Unlock()
+	if lc.client != nil {
+		return lc.client, nil
+	}
+	names := make([]string, 0, len(lc.nodes))
+	for name := range lc.nodes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	providers := make(map[string]provider.Provider, len(names))
+	for _, name := range names {
+		p, err := lc.newProvider(lc.chainID, lc.nodes[name])
+		if err != nil {
+			return nil, fmt.Errorf("failed to create provider for node %s: %w", name, err)
+		}
+		providers[name] = p
+	}
+	primary := providers[names[0]]
+	var witnesses []provider.Provider
+	for _, name := range names[1:] {
+		witnesses = append(witnesses, providers[name])
+	}
+	if len(witnesses) == 0 {
+		// the light client requires a witness, but with a single node there is nothing to compare against, as warned on Start
+		witnesses = append(witnesses, primary)
+	}
+	trust := lc.trust
+	client, err := light.NewClient(ctx, lc.chainID, light.TrustOptions{
+		Period: trust.Period,
+		Height: trust.Height,
+		Hash:   trust.Hash,
+	}, primary, witnesses, dbs.New(dbm.NewMemDB(), lc.chainID))
+	if err != nil {
+		return nil, err
+	}
+	// headers can only be verified from a header within the trust period
+	trusted, err := client.TrustedLightBlock(trust.Height)
+	if err != nil {
+		return nil, err
+	}
+	if expiry := trusted.Time.Add(trust.Period); !time.Now().Before(expiry) {
+		return nil, fmt.Errorf("trusted header at height %d expired at %s: update LightClientTrustedHeight and LightClientTrustedHash to a recent header",
+			trust.Height, expiry)
+	}
+	lc.client, lc.providers = client, providers
+	return client, nil
+}
+
+// crossCheck compares the header of block with those served by each node, and flags the nodes serving another one.
+// Nodes which fail to serve a header are not flagged, since they may just be lagging behind.
+func (lc *LightClient) crossCheck(ctx context.Context, block *cmttypes.LightBlock) {
+	lc.mu.Lock()
+	if block.Height <= lc.checked {
+		lc.mu.Unlock()
+		return
+	}
+	lc.checked = block.Height
+	lc.mu.Unlock()
+
+	for name, p := range lc.providers {
+		got, err := p.LightBlock(ctx, block.Height)
+		if err != nil {
+			lc.lggr.Debugw("Failed to get light block from node", "node", name, "height", block.Height, "err", err)
+			continue
+		}
+		if bytes.Equal(got.Hash(), block.Hash()) {
+			continue
+		}
+		lc.mu.Lock()
+		lc.conflicts[name] = block.Height
+		lc.mu.Unlock()
+		logger.Criticalw(lc.lggr, "Node served a header conflicting with the verified one", "node", name, "height", block.Height,
+			"hash", got.Hash(), "verifiedHash", block.Hash())
+	}
+}
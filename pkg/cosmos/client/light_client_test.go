@@ -0,0 +1,142 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/light/provider"
+	"github.com/cometbft/cometbft/light/provider/mock"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/version"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+)
+
+const lightChainID = "light-chain"
+
+// signedHeaders returns headers for heights 1 to n, signed by a single validator.
+func signedHeaders(t *testing.T, n int64, start time.Time) (map[int64]*cmttypes.SignedHeader, map[int64]*cmttypes.ValidatorSet) {
+	val, pv := cmttypes.RandValidator(false, 10)
+	vals := cmttypes.NewValidatorSet([]*cmttypes.Validator{val})
+	headers := map[int64]*cmttypes.SignedHeader{}
+	valSets := map[int64]*cmttypes.ValidatorSet{}
+	for height := int64(1); height <= n; height++ {
+		header := &cmttypes.Header{
+			Version:            cmtversion.Consensus{Block: version.BlockProtocol},
+			ChainID:            lightChainID,
+			Height:             height,
+			Time:               start.Add(time.Duration(height) * time.Second),
+			ValidatorsHash:     vals.Hash(),
+			NextValidatorsHash: vals.Hash(),
+			ProposerAddress:    val.Address,
+			AppHash:            tmhash.Sum([]byte{byte(height)}),
+		}
+		blockID := cmttypes.BlockID{Hash: header.Hash(), PartSetHeader: cmttypes.PartSetHeader{Total: 1, Hash: tmhash.Sum(header.Hash())}}
+		voteSet := cmttypes.NewVoteSet(lightChainID, height, 1, cmtproto.PrecommitType, vals)
+		commit, err := cmttypes.MakeCommit(blockID, height, 1, voteSet, []cmttypes.PrivValidator{pv}, header.Time)
+		require.NoError(t, err)
+		headers[height] = &cmttypes.SignedHeader{Header: header, Commit: commit}
+		valSets[height] = vals
+	}
+	return headers, valSets
+}
+
+func TestLightClient(t *testing.T) {
+	start := time.Now().Add(-time.Hour)
+	headers, vals := signedHeaders(t, 3, start)
+	// a node serving a header signed by another validator at height 3
+	forged, forgedVals := signedHeaders(t, 3, start)
+	forgedHeaders := map[int64]*cmttypes.SignedHeader{1: headers[1], 2: headers[2], 3: forged[3]}
+	forgedValSets := map[int64]*cmttypes.ValidatorSet{1: vals[1], 2: vals[2], 3: forgedVals[3]}
+	providers := map[string]provider.Provider{
+		"a": mock.New(lightChainID, headers, vals),
+		"b": mock.New(lightChainID, headers, vals),
+		"c": mock.New(lightChainID, forgedHeaders, forgedValSets),
+	}
+
+	lc := NewLightClient(lightChainID, LightClientTrust{Height: 1, Hash: headers[1].Hash()}, map[string]string{"a": "a", "b": "b", "c": "c"}, nil, logger.Test(t))
+	lc.newProvider = func(_, url string) (provider.Provider, error) { return providers[url], nil }
+
+	_, err := lc.VerifyLightBlockAtHeight(tests.Context(t), 2, time.Now())
+	require.Error(t, err, "not started")
+	require.NoError(t, lc.Start(tests.Context(t)))
+	t.Cleanup(func() { require.NoError(t, lc.Close()) })
+
+	block, err := lc.VerifyLightBlockAtHeight(tests.Context(t), 2, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, headers[2].Hash(), block.Hash())
+	assert.Empty(t, lc.ConflictingNodes())
+	require.NoError(t, lc.HealthReport()[lc.Name()])
+
+	block, err = lc.VerifyLightBlockAtHeight(tests.Context(t), 3, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, headers[3].Hash(), block.Hash())
+	assert.Equal(t, map[string]int64{"c": 3}, lc.ConflictingNodes())
+	require.ErrorContains(t, lc.HealthReport()[lc.Name()], "nodes serving conflicting headers")
+}
+
+type memTrustStore struct {
+	height  int64
+	hash    []byte
+	loadErr error
+}
+
+func (s *memTrustStore) LoadTrustedHeader(context.Context) (int64, []byte, error) {
+	return s.height, s.hash, s.loadErr
+}
+
+func (s *memTrustStore) SaveTrustedHeader(_ context.Context, height int64, hash []byte) error {
+	s.height, s.hash = height, hash
+	return nil
+}
+
+func TestLightClient_TrustStore(t *testing.T) {
+	start := time.Now().Add(-time.Hour)
+	headers, vals := signedHeaders(t, 3, start)
+	newLightClient := func(t *testing.T, trust LightClientTrust, store LightClientTrustStore, p provider.Provider) *LightClient {
+		lc := NewLightClient(lightChainID, trust, map[string]string{"a": "a"}, store, logger.Test(t))
+		lc.newProvider = func(string, string) (provider.Provider, error) { return p, nil }
+		require.NoError(t, lc.Start(tests.Context(t)))
+		t.Cleanup(func() { require.NoError(t, lc.Close()) })
+		return lc
+	}
+	trust := LightClientTrust{Height: 1, Hash: headers[1].Hash(), Period: 2 * time.Hour}
+
+	store := &memTrustStore{}
+	lc := newLightClient(t, trust, store, mock.New(lightChainID, headers, vals))
+	_, err := lc.VerifyLightBlockAtHeight(tests.Context(t), 3, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), store.height)
+	assert.Equal(t, []byte(headers[3].Hash()), store.hash)
+
+	// after a restart, the saved header is trusted rather than the configured one, which nodes may have pruned
+	pruned := mock.New(lightChainID,
+		map[int64]*cmttypes.SignedHeader{3: headers[3]}, map[int64]*cmttypes.ValidatorSet{3: vals[3]})
+	restarted := newLightClient(t, trust, store, pruned)
+	block, err := restarted.VerifyLightBlockAtHeight(tests.Context(t), 3, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, headers[3].Hash(), block.Hash())
+
+	t.Run("missing table", func(t *testing.T) {
+		store := &memTrustStore{loadErr: errors.New(`relation "cosmos_light_client_headers" does not exist`)}
+		lc := NewLightClient(lightChainID, trust, map[string]string{"a": "a"}, store, logger.Test(t))
+		require.ErrorContains(t, lc.Start(tests.Context(t)), "failed to load saved trusted header")
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		expired := trust
+		expired.Period = 30 * time.Minute
+		lc := newLightClient(t, expired, nil, mock.New(lightChainID, headers, vals))
+		_, err := lc.VerifyLightBlockAtHeight(tests.Context(t), 3, time.Now())
+		require.ErrorContains(t, err, "update LightClientTrustedHeight and LightClientTrustedHash")
+		require.ErrorContains(t, lc.HealthReport()[lc.Name()], "trusted header at height 1 expired")
+	})
+}
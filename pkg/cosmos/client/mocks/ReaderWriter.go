@@ -3,6 +3,12 @@
 package mocks
 
 import (
+	context "context"
+
+	cometbfttypes "github.com/cometbft/cometbft/types"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
 	cosmos_sdkclient "github.com/cosmos/cosmos-sdk/client"
 
 	client "github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/client"
@@ -18,6 +24,8 @@ import (
 	tx "github.com/cosmos/cosmos-sdk/types/tx"
 
 	types "github.com/cosmos/cosmos-sdk/types"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // ReaderWriter is an autogenerated mock type for the ReaderWriter type
@@ -56,6 +64,61 @@ func (_m *ReaderWriter) Account(address types.AccAddress) (uint64, uint64, error
 	return r0, r1, r2
 }
 
+// AccountAt provides a mock function with given fields: address, height
+func (_m *ReaderWriter) AccountAt(address types.AccAddress, height int64) (uint64, uint64, error) {
+	ret := _m.Called(address, height)
+
+	var r0 uint64
+	var r1 uint64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(types.AccAddress, int64) (uint64, uint64, error)); ok {
+		return rf(address, height)
+	}
+	if rf, ok := ret.Get(0).(func(types.AccAddress, int64) uint64); ok {
+		r0 = rf(address, height)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.AccAddress, int64) uint64); ok {
+		r1 = rf(address, height)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	if rf, ok := ret.Get(2).(func(types.AccAddress, int64) error); ok {
+		r2 = rf(address, height)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// AddressString provides a mock function with given fields: addr
+func (_m *ReaderWriter) AddressString(addr types.AccAddress) (string, error) {
+	ret := _m.Called(addr)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.AccAddress) (string, error)); ok {
+		return rf(addr)
+	}
+	if rf, ok := ret.Get(0).(func(types.AccAddress) string); ok {
+		r0 = rf(addr)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.AccAddress) error); ok {
+		r1 = rf(addr)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Balance provides a mock function with given fields: addr, denom
 func (_m *ReaderWriter) Balance(addr types.AccAddress, denom string) (*types.Coin, error) {
 	ret := _m.Called(addr, denom)
@@ -82,6 +145,58 @@ func (_m *ReaderWriter) Balance(addr types.AccAddress, denom string) (*types.Coi
 	return r0, r1
 }
 
+// BalanceAt provides a mock function with given fields: addr, denom, height
+func (_m *ReaderWriter) BalanceAt(addr types.AccAddress, denom string, height int64) (*types.Coin, error) {
+	ret := _m.Called(addr, denom, height)
+
+	var r0 *types.Coin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.AccAddress, string, int64) (*types.Coin, error)); ok {
+		return rf(addr, denom, height)
+	}
+	if rf, ok := ret.Get(0).(func(types.AccAddress, string, int64) *types.Coin); ok {
+		r0 = rf(addr, denom, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Coin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(types.AccAddress, string, int64) error); ok {
+		r1 = rf(addr, denom, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BatchContractState provides a mock function with given fields: queries
+func (_m *ReaderWriter) BatchContractState(queries []client.ContractQuery) ([]client.ContractQueryResult, error) {
+	ret := _m.Called(queries)
+
+	var r0 []client.ContractQueryResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]client.ContractQuery) ([]client.ContractQueryResult, error)); ok {
+		return rf(queries)
+	}
+	if rf, ok := ret.Get(0).(func([]client.ContractQuery) []client.ContractQueryResult); ok {
+		r0 = rf(queries)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.ContractQueryResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]client.ContractQuery) error); ok {
+		r1 = rf(queries)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // BatchSimulateUnsigned provides a mock function with given fields: msgs, sequence
 func (_m *ReaderWriter) BatchSimulateUnsigned(msgs client.SimMsgs, sequence uint64) (*client.BatchSimResults, error) {
 	ret := _m.Called(msgs, sequence)
@@ -202,6 +317,32 @@ func (_m *ReaderWriter) ContractState(contractAddress types.AccAddress, queryMsg
 	return r0, r1
 }
 
+// ContractStateAt provides a mock function with given fields: contractAddress, queryMsg, height
+func (_m *ReaderWriter) ContractStateAt(contractAddress types.AccAddress, queryMsg []byte, height int64) ([]byte, error) {
+	ret := _m.Called(contractAddress, queryMsg, height)
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.AccAddress, []byte, int64) ([]byte, error)); ok {
+		return rf(contractAddress, queryMsg, height)
+	}
+	if rf, ok := ret.Get(0).(func(types.AccAddress, []byte, int64) []byte); ok {
+		r0 = rf(contractAddress, queryMsg, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(types.AccAddress, []byte, int64) error); ok {
+		r1 = rf(contractAddress, queryMsg, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateAndSign provides a mock function with given fields: msgs, account, sequence, gasLimit, gasLimitMultiplier, gasPrice, signer, timeoutHeight
 func (_m *ReaderWriter) CreateAndSign(msgs []types.Msg, account uint64, sequence uint64, gasLimit uint64, gasLimitMultiplier float64, gasPrice types.DecCoin, signer cryptotypes.PrivKey, timeoutHeight uint64) ([]byte, error) {
 	ret := _m.Called(msgs, account, sequence, gasLimit, gasLimitMultiplier, gasPrice, signer, timeoutHeight)
@@ -228,6 +369,80 @@ func (_m *ReaderWriter) CreateAndSign(msgs []types.Msg, account uint64, sequence
 	return r0, r1
 }
 
+// DenomMetadata provides a mock function with given fields: denom
+func (_m *ReaderWriter) DenomMetadata(denom string) (*banktypes.Metadata, error) {
+	ret := _m.Called(denom)
+
+	var r0 *banktypes.Metadata
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*banktypes.Metadata, error)); ok {
+		return rf(denom)
+	}
+	if rf, ok := ret.Get(0).(func(string) *banktypes.Metadata); ok {
+		r0 = rf(denom)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*banktypes.Metadata)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(denom)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EIP1559BaseFee provides a mock function with given fields:
+func (_m *ReaderWriter) EIP1559BaseFee() (types.Dec, error) {
+	ret := _m.Called()
+
+	var r0 types.Dec
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (types.Dec, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() types.Dec); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(types.Dec)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeeMarketGasPrice provides a mock function with given fields: denom
+func (_m *ReaderWriter) FeeMarketGasPrice(denom string) (types.DecCoin, error) {
+	ret := _m.Called(denom)
+
+	var r0 types.DecCoin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (types.DecCoin, error)); ok {
+		return rf(denom)
+	}
+	if rf, ok := ret.Get(0).(func(string) types.DecCoin); ok {
+		r0 = rf(denom)
+	} else {
+		r0 = ret.Get(0).(types.DecCoin)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(denom)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LatestBlock provides a mock function with given fields:
 func (_m *ReaderWriter) LatestBlock() (*tmservice.GetLatestBlockResponse, error) {
 	ret := _m.Called()
@@ -254,6 +469,58 @@ func (_m *ReaderWriter) LatestBlock() (*tmservice.GetLatestBlockResponse, error)
 	return r0, r1
 }
 
+// MinimumGasPrices provides a mock function with given fields:
+func (_m *ReaderWriter) MinimumGasPrices() (types.DecCoins, error) {
+	ret := _m.Called()
+
+	var r0 types.DecCoins
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (types.DecCoins, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() types.DecCoins); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.DecCoins)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RawContractState provides a mock function with given fields: contractAddress, key
+func (_m *ReaderWriter) RawContractState(contractAddress types.AccAddress, key []byte) ([]byte, error) {
+	ret := _m.Called(contractAddress, key)
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.AccAddress, []byte) ([]byte, error)); ok {
+		return rf(contractAddress, key)
+	}
+	if rf, ok := ret.Get(0).(func(types.AccAddress, []byte) []byte); ok {
+		r0 = rf(contractAddress, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(types.AccAddress, []byte) error); ok {
+		r1 = rf(contractAddress, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SignAndBroadcast provides a mock function with given fields: msgs, accountNum, sequence, gasPrice, signer, mode
 func (_m *ReaderWriter) SignAndBroadcast(msgs []types.Msg, accountNum uint64, sequence uint64, gasPrice types.DecCoin, signer cryptotypes.PrivKey, mode tx.BroadcastMode) (*tx.BroadcastTxResponse, error) {
 	ret := _m.Called(msgs, accountNum, sequence, gasPrice, signer, mode)
@@ -332,6 +599,136 @@ func (_m *ReaderWriter) SimulateUnsigned(msgs []types.Msg, sequence uint64) (*tx
 	return r0, r1
 }
 
+// Status provides a mock function with given fields:
+func (_m *ReaderWriter) Status() (*coretypes.ResultStatus, error) {
+	ret := _m.Called()
+
+	var r0 *coretypes.ResultStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*coretypes.ResultStatus, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *coretypes.ResultStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubscribeNewBlockHeaders provides a mock function with given fields: ctx
+func (_m *ReaderWriter) SubscribeNewBlockHeaders(ctx context.Context) (<-chan cometbfttypes.EventDataNewBlockHeader, error) {
+	ret := _m.Called(ctx)
+
+	var r0 <-chan cometbfttypes.EventDataNewBlockHeader
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (<-chan cometbfttypes.EventDataNewBlockHeader, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) <-chan cometbfttypes.EventDataNewBlockHeader); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan cometbfttypes.EventDataNewBlockHeader)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubscribeTx provides a mock function with given fields: ctx, txHash
+func (_m *ReaderWriter) SubscribeTx(ctx context.Context, txHash string) (<-chan cometbfttypes.EventDataTx, error) {
+	ret := _m.Called(ctx, txHash)
+
+	var r0 <-chan cometbfttypes.EventDataTx
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (<-chan cometbfttypes.EventDataTx, error)); ok {
+		return rf(ctx, txHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) <-chan cometbfttypes.EventDataTx); ok {
+		r0 = rf(ctx, txHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan cometbfttypes.EventDataTx)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, txHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubscribeTxs provides a mock function with given fields: ctx, query
+func (_m *ReaderWriter) SubscribeTxs(ctx context.Context, query string) (<-chan cometbfttypes.EventDataTx, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 <-chan cometbfttypes.EventDataTx
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (<-chan cometbfttypes.EventDataTx, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) <-chan cometbfttypes.EventDataTx); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan cometbfttypes.EventDataTx)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SupplyOf provides a mock function with given fields: denom
+func (_m *ReaderWriter) SupplyOf(denom string) (*types.Coin, error) {
+	ret := _m.Called(denom)
+
+	var r0 *types.Coin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*types.Coin, error)); ok {
+		return rf(denom)
+	}
+	if rf, ok := ret.Get(0).(func(string) *types.Coin); ok {
+		r0 = rf(denom)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Coin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(denom)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Tx provides a mock function with given fields: hash
 func (_m *ReaderWriter) Tx(hash string) (*tx.GetTxResponse, error) {
 	ret := _m.Called(hash)
@@ -384,6 +781,32 @@ func (_m *ReaderWriter) TxsEvents(events []string, paginationParams *query.PageR
 	return r0, r1
 }
 
+// TxsEventsPage provides a mock function with given fields: events, orderBy, page, limit
+func (_m *ReaderWriter) TxsEventsPage(events []string, orderBy tx.OrderBy, page uint64, limit uint64) (*tx.GetTxsEventResponse, error) {
+	ret := _m.Called(events, orderBy, page, limit)
+
+	var r0 *tx.GetTxsEventResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string, tx.OrderBy, uint64, uint64) (*tx.GetTxsEventResponse, error)); ok {
+		return rf(events, orderBy, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func([]string, tx.OrderBy, uint64, uint64) *tx.GetTxsEventResponse); ok {
+		r0 = rf(events, orderBy, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*tx.GetTxsEventResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string, tx.OrderBy, uint64, uint64) error); ok {
+		r1 = rf(events, orderBy, page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewReaderWriter interface {
 	mock.TestingT
 	Cleanup(func())
@@ -0,0 +1,335 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
+	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	libclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/jpillora/backoff"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+const (
+	// subscriptionBufferSize is the capacity of each subscription channel.
+	// Events are dropped, with a warning, when a subscriber falls this far behind.
+	subscriptionBufferSize = 16
+	// wsMaxReconnectAttempts bounds the reconnect attempts of the underlying websocket client,
+	// which backs off exponentially between attempts (1s, 2s, 4s, ...). Once exhausted, the
+	// subscriber takes over and dials a fresh connection with a capped backoff.
+	wsMaxReconnectAttempts = 5
+	// wsPingPeriod is how often the connection is pinged, so that dead connections are detected and redialed.
+	wsPingPeriod = 30 * time.Second
+	// wsWriteWait is how long a write, including a ping, may take before the connection is considered dead.
+	wsWriteWait = 10 * time.Second
+)
+
+// EventSubscriber provides methods for subscribing to CometBFT events over the node's websocket endpoint.
+// Subscriptions survive websocket disconnects: the connection is re-established and every active query
+// is resubscribed. Returned channels are closed once ctx is done.
+type EventSubscriber interface {
+	// SubscribeNewBlockHeaders subscribes to the header of every new block.
+	SubscribeNewBlockHeaders(ctx context.Context) (<-chan cmttypes.EventDataNewBlockHeader, error)
+	// SubscribeTxs subscribes to txs with events matching query, e.g. "wasm._contract_address='wasm1...'".
+	SubscribeTxs(ctx context.Context, query string) (<-chan cmttypes.EventDataTx, error)
+	// SubscribeTx subscribes to the inclusion of the tx with the given hash.
+	SubscribeTx(ctx context.Context, txHash string) (<-chan cmttypes.EventDataTx, error)
+}
+
+// SubscribeNewBlockHeaders subscribes to the header of every new block.
+func (c *Client) SubscribeNewBlockHeaders(ctx context.Context) (<-chan cmttypes.EventDataNewBlockHeader, error) {
+	events, err := c.subscriber.subscribe(ctx, cmttypes.EventQueryNewBlockHeader.String())
+	if err != nil {
+		return nil, err
+	}
+	return forwardEvents[cmttypes.EventDataNewBlockHeader](ctx, events, c.log), nil
+}
+
+// SubscribeTxs subscribes to txs with events matching query.
+// The query follows the CometBFT query language, and is ANDed with tm.event='Tx'.
+func (c *Client) SubscribeTxs(ctx context.Context, query string) (<-chan cmttypes.EventDataTx, error) {
+	events, err := c.subscriber.subscribe(ctx, fmt.Sprintf("%s AND %s", cmttypes.EventQueryTx, query))
+	if err != nil {
+		return nil, err
+	}
+	return forwardEvents[cmttypes.EventDataTx](ctx, events, c.log), nil
+}
+
+// SubscribeTx subscribes to the inclusion of the tx with the given hex encoded hash.
+func (c *Client) SubscribeTx(ctx context.Context, txHash string) (<-chan cmttypes.EventDataTx, error) {
+	return c.SubscribeTxs(ctx, fmt.Sprintf("%s='%s'", cmttypes.TxHashKey, strings.ToUpper(txHash)))
+}
+
+// forwardEvents converts raw events into their typed data, until ctx is done.
+func forwardEvents[T cmttypes.TMEventData](ctx context.Context, events <-chan coretypes.ResultEvent, lggr logger.Logger) <-chan T {
+	out := make(chan T, subscriptionBufferSize)
+	go func() {
+		defer close(out)
+		for event := range events {
+			data, ok := event.Data.(T)
+			if !ok {
+				lggr.Warnw("unexpected event data type, skipping", "query", event.Query, "type", fmt.Sprintf("%T", event.Data))
+				continue
+			}
+			select {
+			case out <- data:
+			case <-ctx.Done():
+				// keep draining until events is closed
+			}
+		}
+	}()
+	return out
+}
+
+// subscriber multiplexes event subscriptions over a single websocket connection.
+// The connection is opened with the first subscription and closed with the last one.
+// Should the websocket client give up reconnecting, a new connection is dialed and
+// every active query resubscribed.
+type subscriber struct {
+	remote   string
+	endpoint string
+	timeout  time.Duration
+	lggr     logger.Logger
+
+	mu   sync.Mutex
+	ws   *libclient.WSClient
+	stop chan struct{}
+	subs map[string]map[chan coretypes.ResultEvent]struct{} // query -> listeners
+}
+
+func newSubscriber(remote, endpoint string, timeout time.Duration, lggr logger.Logger) *subscriber {
+	return &subscriber{
+		remote:   remote,
+		endpoint: endpoint,
+		timeout:  timeout,
+		lggr:     logger.Named(lggr, "Subscriber"),
+		subs:     make(map[string]map[chan coretypes.ResultEvent]struct{}),
+	}
+}
+
+// websocketRemote splits a websocket URL into the remote and endpoint of libclient.NewWS,
+// which dials ws and wss URLs by their http and https aliases.
+func websocketRemote(wsURL string) (remote, endpoint string, err error) {
+	u, err := url.Parse(wsURL)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid websocket URL: %w", err)
+	}
+	switch u.Scheme {
+	case "ws":
+		u.Scheme = "http"
+	case "wss":
+		u.Scheme = "https"
+	}
+	endpoint, u.Path, u.RawPath = u.Path, "", ""
+	if endpoint == "" || endpoint == "/" {
+		endpoint = "/websocket"
+	}
+	return u.String(), endpoint, nil
+}
+
+// subscribe returns a channel of events matching query, which is closed once ctx is done.
+func (s *subscriber) subscribe(ctx context.Context, query string) (<-chan coretypes.ResultEvent, error) {
+	if _, err := cmtquery.New(query); err != nil {
+		return nil, fmt.Errorf("invalid query %q: %w", query, err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ws == nil {
+		ws, err := s.dial()
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to websocket: %w", err)
+		}
+		s.ws, s.stop = ws, make(chan struct{})
+		go s.run(ws, s.stop)
+	}
+	listeners, ok := s.subs[query]
+	if !ok {
+		subCtx, cancel := context.WithTimeout(ctx, s.timeout)
+		err := s.ws.Subscribe(subCtx, query)
+		cancel()
+		if err != nil {
+			if len(s.subs) == 0 {
+				s.closeLocked()
+			}
+			return nil, fmt.Errorf("failed to subscribe to %q: %w", query, err)
+		}
+		listeners = make(map[chan coretypes.ResultEvent]struct{})
+		s.subs[query] = listeners
+	}
+	ch := make(chan coretypes.ResultEvent, subscriptionBufferSize)
+	listeners[ch] = struct{}{}
+	go func() {
+		<-ctx.Done()
+		s.unsubscribe(query, ch)
+	}()
+	return ch, nil
+}
+
+func (s *subscriber) unsubscribe(query string, ch chan coretypes.ResultEvent) {
+	s.mu.Lock()
+	listeners := s.subs[query]
+	delete(listeners, ch)
+	close(ch)
+	if len(listeners) > 0 {
+		s.mu.Unlock()
+		return
+	}
+	delete(s.subs, query)
+	if len(s.subs) == 0 {
+		s.closeLocked()
+		s.mu.Unlock()
+		return
+	}
+	ws := s.ws
+	s.mu.Unlock()
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	if err := ws.Unsubscribe(ctx, query); err != nil {
+		s.lggr.Warnw("failed to unsubscribe", "query", query, "err", err)
+	}
+}
+
+func (s *subscriber) closeLocked() {
+	close(s.stop)
+	if err := s.ws.Stop(); err != nil {
+		s.lggr.Warnw("failed to stop websocket client", "err", err)
+	}
+	s.ws, s.stop = nil, nil
+}
+
+func (s *subscriber) dial() (*libclient.WSClient, error) {
+	ws, err := libclient.NewWS(s.remote, s.endpoint,
+		libclient.MaxReconnectAttempts(wsMaxReconnectAttempts),
+		libclient.PingPeriod(wsPingPeriod),
+		libclient.WriteWait(wsWriteWait),
+		libclient.OnReconnect(func() {
+			s.lggr.Infow("websocket reconnected, resubscribing", "remote", s.remote)
+			s.resubscribe()
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err = ws.Start(); err != nil {
+		return nil, err
+	}
+	return ws, nil
+}
+
+// resubscribe subscribes again to every active query on the current connection.
+func (s *subscriber) resubscribe() {
+	s.mu.Lock()
+	ws := s.ws
+	queries := make([]string, 0, len(s.subs))
+	for query := range s.subs {
+		queries = append(queries, query)
+	}
+	s.mu.Unlock()
+	if ws == nil {
+		return
+	}
+	for _, query := range queries {
+		ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+		err := ws.Subscribe(ctx, query)
+		cancel()
+		if err != nil {
+			s.lggr.Errorw("failed to resubscribe", "query", query, "err", err)
+		}
+	}
+}
+
+// run fans out events from ws to listeners until stop is closed.
+func (s *subscriber) run(ws *libclient.WSClient, stop chan struct{}) {
+	b := backoff.Backoff{Min: time.Second, Max: time.Minute, Jitter: true}
+	// resubscribeAfter fires the one pending resubscribe, which errors until then are coalesced into.
+	var resubscribeAfter <-chan time.Time
+	for {
+		select {
+		case <-stop:
+			return
+		case <-resubscribeAfter:
+			resubscribeAfter = nil
+			s.resubscribe()
+		case resp := <-ws.ResponsesCh:
+			if resp.Error != nil {
+				// Already subscribed errors are benign, anything else may mean the node restarted
+				// or we hit the max subscriptions per client.
+				if !strings.Contains(resp.Error.Error(), cmtpubsub.ErrAlreadySubscribed.Error()) {
+					if resubscribeAfter == nil {
+						// back off, so that persistent errors do not resubscribe in a tight loop
+						delay := b.Duration()
+						s.lggr.Errorw("websocket error, resubscribing", "err", resp.Error, "after", delay)
+						resubscribeAfter = time.After(delay)
+					} else {
+						s.lggr.Errorw("websocket error, already resubscribing", "err", resp.Error)
+					}
+				}
+				continue
+			}
+			var event coretypes.ResultEvent
+			if err := cmtjson.Unmarshal(resp.Result, &event); err != nil {
+				s.lggr.Errorw("failed to unmarshal event", "err", err)
+				continue
+			}
+			// responses to (un)subscribe requests have an empty result, and no listeners
+			s.publish(event)
+		case <-ws.Quit():
+			s.lggr.Warnw("websocket client gave up reconnecting, dialing a new connection", "remote", s.remote)
+			ws = s.redial(stop, &b)
+			if ws == nil {
+				return
+			}
+			b.Reset()
+			resubscribeAfter = nil
+			s.resubscribe()
+		}
+	}
+}
+
+func (s *subscriber) publish(event coretypes.ResultEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for ch := range s.subs[event.Query] {
+		select {
+		case ch <- event:
+		default:
+			s.lggr.Warnw("subscription channel full, dropping event", "query", event.Query)
+		}
+	}
+}
+
+// redial dials until a new connection is established, returning nil if stop is closed first.
+func (s *subscriber) redial(stop chan struct{}, b *backoff.Backoff) *libclient.WSClient {
+	for {
+		select {
+		case <-stop:
+			return nil
+		case <-time.After(b.Duration()):
+		}
+		ws, err := s.dial()
+		if err != nil {
+			s.lggr.Errorw("failed to dial websocket", "remote", s.remote, "err", err)
+			continue
+		}
+		s.mu.Lock()
+		select {
+		case <-stop:
+			s.mu.Unlock()
+			_ = ws.Stop()
+			return nil
+		default:
+		}
+		s.ws = ws
+		s.mu.Unlock()
+		return ws
+	}
+}
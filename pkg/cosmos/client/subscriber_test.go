@@ -0,0 +1,252 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+)
+
+// fakeEventServer is a minimal CometBFT websocket endpoint which acknowledges subscriptions and publishes events.
+type fakeEventServer struct {
+	t          *testing.T
+	srv        *httptest.Server
+	subscribed chan string
+
+	mu            sync.Mutex
+	conns         []*websocket.Conn
+	subs          map[string]rpctypes.JSONRPCIntID // query -> subscription request id
+	failSubscribe bool                             // refuse subscriptions, as if the node hit its max subscriptions per client
+}
+
+func newFakeEventServer(t *testing.T) *fakeEventServer {
+	s := &fakeEventServer{
+		t:          t,
+		subscribed: make(chan string, 16),
+		subs:       make(map[string]rpctypes.JSONRPCIntID),
+	}
+	upgrader := websocket.Upgrader{}
+	s.srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.conns = append(s.conns, conn)
+		s.mu.Unlock()
+		for {
+			var req rpctypes.RPCRequest
+			if err := conn.ReadJSON(&req); err != nil {
+				return
+			}
+			var params struct {
+				Query string `json:"query"`
+			}
+			_ = json.Unmarshal(req.Params, &params)
+			s.mu.Lock()
+			resp := rpctypes.NewRPCSuccessResponse(req.ID, struct{}{})
+			if req.Method == "subscribe" && s.failSubscribe {
+				resp = rpctypes.RPCInternalError(req.ID, errors.New("max_subscriptions_per_client reached"))
+			} else if req.Method == "subscribe" {
+				s.subs[params.Query] = req.ID.(rpctypes.JSONRPCIntID)
+			} else if req.Method == "unsubscribe" {
+				delete(s.subs, params.Query)
+			}
+			err := conn.WriteJSON(resp)
+			s.mu.Unlock()
+			if err != nil {
+				return
+			}
+			if req.Method == "subscribe" {
+				s.subscribed <- params.Query
+			}
+		}
+	}))
+	t.Cleanup(s.srv.Close)
+	return s
+}
+
+func (s *fakeEventServer) publish(query string, data cmttypes.TMEventData) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id, ok := s.subs[query]
+	require.True(s.t, ok, "not subscribed to %s", query)
+	conn := s.conns[len(s.conns)-1]
+	require.NoError(s.t, conn.WriteJSON(rpctypes.NewRPCSuccessResponse(id, coretypes.ResultEvent{Query: query, Data: data})))
+}
+
+// dropConnections abruptly closes all connections, as if the node went away.
+func (s *fakeEventServer) dropConnections() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, conn := range s.conns {
+		_ = conn.UnderlyingConn().Close()
+	}
+	s.conns = nil
+	s.subs = make(map[string]rpctypes.JSONRPCIntID)
+}
+
+func (s *fakeEventServer) awaitSubscribed(t *testing.T, query string) {
+	for {
+		select {
+		case q := <-s.subscribed:
+			if q == query {
+				return
+			}
+		case <-time.After(tests.WaitTimeout(t)):
+			t.Fatalf("timed out waiting for subscription to %s", query)
+		}
+	}
+}
+
+func TestSubscriber(t *testing.T) {
+	headerQuery := cmttypes.EventQueryNewBlockHeader.String()
+	header := func(height int64) cmttypes.EventDataNewBlockHeader {
+		return cmttypes.EventDataNewBlockHeader{Header: cmttypes.Header{ChainID: "42", Height: height}}
+	}
+	receive := func(t *testing.T, ch <-chan cmttypes.EventDataNewBlockHeader) cmttypes.EventDataNewBlockHeader {
+		select {
+		case h, ok := <-ch:
+			require.True(t, ok, "channel closed")
+			return h
+		case <-time.After(tests.WaitTimeout(t)):
+			t.Fatal("timed out waiting for event")
+		}
+		return cmttypes.EventDataNewBlockHeader{}
+	}
+
+	t.Run("new block headers", func(t *testing.T) {
+		srv := newFakeEventServer(t)
+		tc, err := NewClient("42", srv.srv.URL, time.Second, logger.Test(t))
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(tests.Context(t))
+		headers, err := tc.SubscribeNewBlockHeaders(ctx)
+		require.NoError(t, err)
+		srv.awaitSubscribed(t, headerQuery)
+
+		srv.publish(headerQuery, header(7))
+		assert.Equal(t, int64(7), receive(t, headers).Header.Height)
+
+		cancel()
+		for range headers {
+		}
+	})
+
+	t.Run("shared query", func(t *testing.T) {
+		srv := newFakeEventServer(t)
+		tc, err := NewClient("42", srv.srv.URL, time.Second, logger.Test(t))
+		require.NoError(t, err)
+
+		ctx := tests.Context(t)
+		first, err := tc.SubscribeNewBlockHeaders(ctx)
+		require.NoError(t, err)
+		srv.awaitSubscribed(t, headerQuery)
+		second, err := tc.SubscribeNewBlockHeaders(ctx)
+		require.NoError(t, err)
+
+		srv.publish(headerQuery, header(8))
+		assert.Equal(t, int64(8), receive(t, first).Header.Height)
+		assert.Equal(t, int64(8), receive(t, second).Header.Height)
+		assert.Len(t, srv.subscribed, 0, "query should only be subscribed once")
+	})
+
+	t.Run("tx hash", func(t *testing.T) {
+		srv := newFakeEventServer(t)
+		tc, err := NewClient("42", srv.srv.URL, time.Second, logger.Test(t))
+		require.NoError(t, err)
+
+		txs, err := tc.SubscribeTx(tests.Context(t), "4bf5122f")
+		require.NoError(t, err)
+		query := "tm.event='Tx' AND tx.hash='4BF5122F'"
+		srv.awaitSubscribed(t, query)
+
+		srv.publish(query, cmttypes.EventDataTx{TxResult: abci.TxResult{Height: 9}})
+		select {
+		case tx := <-txs:
+			assert.Equal(t, int64(9), tx.Height)
+		case <-time.After(tests.WaitTimeout(t)):
+			t.Fatal("timed out waiting for tx")
+		}
+	})
+
+	t.Run("resubscribes after reconnect", func(t *testing.T) {
+		srv := newFakeEventServer(t)
+		tc, err := NewClient("42", srv.srv.URL, time.Second, logger.Test(t))
+		require.NoError(t, err)
+
+		headers, err := tc.SubscribeNewBlockHeaders(tests.Context(t))
+		require.NoError(t, err)
+		srv.awaitSubscribed(t, headerQuery)
+
+		srv.dropConnections()
+		srv.awaitSubscribed(t, headerQuery)
+
+		srv.publish(headerQuery, header(10))
+		assert.Equal(t, int64(10), receive(t, headers).Header.Height)
+	})
+
+	t.Run("coalesces resubscribes on persistent errors", func(t *testing.T) {
+		srv := newFakeEventServer(t)
+		srv.failSubscribe = true
+		tc, err := NewClient("42", srv.srv.URL, time.Second, logger.Test(t))
+		require.NoError(t, err)
+
+		txQuery := fmt.Sprintf("%s AND wasm._contract_address='contract'", cmttypes.EventQueryTx)
+		_, err = tc.SubscribeNewBlockHeaders(tests.Context(t))
+		require.NoError(t, err)
+		_, err = tc.SubscribeTxs(tests.Context(t), "wasm._contract_address='contract'")
+		require.NoError(t, err)
+		srv.awaitSubscribed(t, headerQuery)
+		srv.awaitSubscribed(t, txQuery)
+
+		// both errors are resubscribed by a single attempt of each query
+		srv.awaitSubscribed(t, headerQuery)
+		srv.awaitSubscribed(t, txQuery)
+		select {
+		case q := <-srv.subscribed:
+			t.Fatalf("unexpected resubscribe to %s before the backoff", q)
+		case <-time.After(500 * time.Millisecond):
+		}
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		srv := newFakeEventServer(t)
+		tc, err := NewClient("42", srv.srv.URL, time.Second, logger.Test(t))
+		require.NoError(t, err)
+
+		_, err = tc.SubscribeTxs(tests.Context(t), "wasm._contract_address=")
+		require.Error(t, err)
+	})
+}
+
+func TestWebsocketRemote(t *testing.T) {
+	for _, tt := range []struct {
+		url, remote, endpoint string
+	}{
+		{"ws://node:26657", "http://node:26657", "/websocket"},
+		{"wss://node/ws", "https://node", "/ws"},
+		{"https://node:443/", "https://node:443", "/websocket"},
+	} {
+		remote, endpoint, err := websocketRemote(tt.url)
+		require.NoError(t, err)
+		assert.Equal(t, tt.remote, remote, tt.url)
+		assert.Equal(t, tt.endpoint, endpoint, tt.url)
+	}
+}
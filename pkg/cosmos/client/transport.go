@@ -0,0 +1,252 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
+)
+
+// RateLimiter spaces out requests to a node. It is safe for concurrent use, and meant to be shared by all the
+// clients of a node, since a new client is usually created per use.
+type RateLimiter struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// NewRateLimiter returns a RateLimiter allowing perSecond requests per second, or nil if perSecond is not positive.
+func NewRateLimiter(perSecond int64) *RateLimiter {
+	if perSecond <= 0 {
+		return nil
+	}
+	return &RateLimiter{interval: time.Second / time.Duration(perSecond)}
+}
+
+// Wait blocks until the next request may be sent, or ctx is done. A nil RateLimiter never blocks.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	d := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+	if d <= 0 {
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// NodeHealth tracks whether a node is reachable, from the transport errors of its requests.
+// Like RateLimiter, it is meant to be shared by all the clients of a node.
+type NodeHealth struct {
+	mu       sync.Mutex
+	err      error
+	failedAt time.Time
+}
+
+// Err returns the error of the last request, if it failed to reach the node. A nil NodeHealth is always healthy.
+func (h *NodeHealth) Err() error {
+	if h == nil {
+		return nil
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.err
+}
+
+// FailedAt returns the time of the last request, if it failed to reach the node, or the zero time otherwise.
+func (h *NodeHealth) FailedAt() time.Time {
+	if h == nil {
+		return time.Time{}
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.failedAt
+}
+
+func (h *NodeHealth) record(err error) {
+	if h == nil {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.err = err
+	h.failedAt = time.Time{}
+	if err != nil {
+		h.failedAt = time.Now()
+	}
+}
+
+// WithNodeHealth records the health of the node in h.
+func WithNodeHealth(h *NodeHealth) ClientOption {
+	return func(c *Client) {
+		c.health = h
+	}
+}
+
+// Auth holds the credentials sent with every HTTP and gRPC request, as required by some hosted RPC providers.
+// They are not sent on websocket connections, which do not support custom headers.
+type Auth struct {
+	BasicAuthUser     string
+	BasicAuthPassword string
+	Headers           map[string]string
+}
+
+func (a Auth) empty() bool {
+	return a.BasicAuthUser == "" && len(a.Headers) == 0
+}
+
+func (a Auth) basicAuthHeader() string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(a.BasicAuthUser+":"+a.BasicAuthPassword))
+}
+
+// WithAuth sends the credentials of auth with every request.
+func WithAuth(auth Auth) ClientOption {
+	return func(c *Client) {
+		c.auth = auth
+	}
+}
+
+// WithRateLimiter limits the rate of requests sent to the node, see RateLimiter.
+func WithRateLimiter(limiter *RateLimiter) ClientOption {
+	return func(c *Client) {
+		c.limiter = limiter
+	}
+}
+
+// WithGRPCConn sends queries over conn, rather than through the ABCI queries of the Tendermint RPC.
+// Broadcasts and Tendermint requests still go to the Tendermint RPC. See DialGRPC.
+func WithGRPCConn(conn *grpc.ClientConn) ClientOption {
+	return func(c *Client) {
+		c.grpcConn = conn
+	}
+}
+
+// WithWebsocketURL subscribes to events through the websocket endpoint at wsURL, rather than
+// the /websocket endpoint of the Tendermint RPC.
+func WithWebsocketURL(wsURL string) ClientOption {
+	return func(c *Client) {
+		c.websocketURL = wsURL
+	}
+}
+
+// transport applies the rate limit and credentials of a node to HTTP requests, and records its health.
+type transport struct {
+	base    http.RoundTripper
+	limiter *RateLimiter
+	auth    Auth
+	health  *NodeHealth
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	if !t.auth.empty() {
+		// RoundTrippers must not modify the request
+		req = req.Clone(req.Context())
+		for k, v := range t.auth.Headers {
+			req.Header.Set(k, v)
+		}
+		if t.auth.BasicAuthUser != "" {
+			req.SetBasicAuth(t.auth.BasicAuthUser, t.auth.BasicAuthPassword)
+		}
+	}
+	resp, err := t.base.RoundTrip(req)
+	switch {
+	case err != nil:
+		t.health.record(err)
+	case resp.StatusCode >= http.StatusInternalServerError:
+		t.health.record(fmt.Errorf("unexpected status: %s", resp.Status))
+	default:
+		t.health.record(nil)
+	}
+	return resp, err
+}
+
+func (c *Client) wrapTransport(base http.RoundTripper) http.RoundTripper {
+	if c.limiter == nil && c.auth.empty() && c.health == nil {
+		return base
+	}
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{base: base, limiter: c.limiter, auth: c.auth, health: c.health}
+}
+
+// DialGRPC dials the gRPC endpoint of a node at grpcURL, for WithGRPCConn. The https scheme dials with TLS,
+// and http without. Requests are bounded by requestTimeout, and sent with the rate limit and credentials of the node.
+// Transport errors are recorded in health. The connection is meant to be shared by the clients of the node,
+// and must be closed by the caller.
+func DialGRPC(grpcURL string, requestTimeout time.Duration, limiter *RateLimiter, auth Auth, health *NodeHealth) (*grpc.ClientConn, error) {
+	u, err := url.Parse(grpcURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gRPC URL: %w", err)
+	}
+	var creds credentials.TransportCredentials
+	switch u.Scheme {
+	case "https":
+		creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	case "http":
+		creds = insecure.NewCredentials()
+	default:
+		return nil, fmt.Errorf("unsupported gRPC URL scheme %q, must be http or https", u.Scheme)
+	}
+	if requestTimeout <= 0 {
+		requestTimeout = DefaultTimeout
+	}
+	md := metadata.New(auth.Headers)
+	if auth.BasicAuthUser != "" {
+		md.Set("authorization", auth.basicAuthHeader())
+	}
+	interceptor := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if err := limiter.Wait(ctx); err != nil {
+			return err
+		}
+		for k, vs := range md {
+			ctx = metadata.AppendToOutgoingContext(ctx, k, vs[0])
+		}
+		ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+		defer cancel()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if IsTransient(err) {
+			health.record(err)
+		} else {
+			health.record(nil)
+		}
+		return err
+	}
+	// the SDK messages are gogoproto messages, which need the SDK codec
+	grpcCodec := codec.NewProtoCodec(params.NewClientContext().InterfaceRegistry).GRPCCodec()
+	return grpc.Dial(u.Host,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithUnaryInterceptor(interceptor),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(grpcCodec)),
+	)
+}
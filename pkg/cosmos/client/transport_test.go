@@ -0,0 +1,132 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+func TestRateLimiter(t *testing.T) {
+	assert.Nil(t, NewRateLimiter(0))
+	require.NoError(t, (*RateLimiter)(nil).Wait(context.Background()))
+
+	limiter := NewRateLimiter(20) // 50ms apart
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, limiter.Wait(context.Background()))
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	limited := NewRateLimiter(1)
+	require.NoError(t, limited.Wait(context.Background()))
+	assert.ErrorIs(t, limited.Wait(ctx), context.Canceled)
+}
+
+func TestClient_Transport(t *testing.T) {
+	requests := make(chan *http.Request, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case requests <- r:
+		default: // retries
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(srv.Close)
+
+	health := &NodeHealth{}
+	c, err := NewClient("chain", srv.URL, time.Second, logger.Test(t),
+		WithAuth(Auth{BasicAuthUser: "user", BasicAuthPassword: "password", Headers: map[string]string{"X-Api-Key": "key"}}),
+		WithRateLimiter(NewRateLimiter(100)),
+		WithNodeHealth(health),
+	)
+	require.NoError(t, err)
+	_, err = c.Status()
+	require.Error(t, err)
+	assert.ErrorContains(t, health.Err(), "503")
+	assert.False(t, health.FailedAt().IsZero())
+
+	r := <-requests
+	user, password, ok := r.BasicAuth()
+	assert.True(t, ok)
+	assert.Equal(t, "user", user)
+	assert.Equal(t, "password", password)
+	assert.Equal(t, "key", r.Header.Get("X-Api-Key"))
+}
+
+func TestDialGRPC(t *testing.T) {
+	_, err := DialGRPC("tcp://node:9090", time.Second, nil, Auth{}, nil)
+	assert.ErrorContains(t, err, "unsupported gRPC URL scheme")
+
+	conn, err := DialGRPC("http://node:9090", time.Second, nil, Auth{}, nil)
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+}
+
+// fakeTxService answers broadcasts with a fixed response code, optionally after a delay.
+type fakeTxService struct {
+	txtypes.ServiceClient
+	code  uint32
+	err   error
+	delay time.Duration
+}
+
+func (s *fakeTxService) BroadcastTx(_ context.Context, _ *txtypes.BroadcastTxRequest, _ ...grpc.CallOption) (*txtypes.BroadcastTxResponse, error) {
+	time.Sleep(s.delay)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &txtypes.BroadcastTxResponse{TxResponse: &sdk.TxResponse{TxHash: "HASH", Code: s.code, Codespace: "sdk"}}, nil
+}
+
+func TestClient_BroadcastFanOut(t *testing.T) {
+	lggr := logger.Test(t)
+	node := func(s *fakeTxService) *Client {
+		return &Client{cosmosServiceClient: s, log: lggr}
+	}
+	unreachable := &fakeTxService{err: status.Error(codes.Unavailable, "unreachable")}
+	mempoolFull := &fakeTxService{code: sdkerrors.ErrMempoolIsFull.ABCICode()}
+	inCache := &fakeTxService{code: sdkerrors.ErrTxInMempoolCache.ABCICode()}
+	accepted := &fakeTxService{delay: 10 * time.Millisecond}
+
+	t.Run("first accepted", func(t *testing.T) {
+		c := &Client{log: lggr, broadcasters: []*Client{node(unreachable), node(accepted), node(mempoolFull)}}
+		res, err := c.Broadcast([]byte{1}, txtypes.BroadcastMode_BROADCAST_MODE_SYNC)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(0), res.TxResponse.Code)
+	})
+
+	t.Run("already in cache", func(t *testing.T) {
+		c := &Client{log: lggr, broadcasters: []*Client{node(unreachable), node(inCache)}}
+		res, err := c.Broadcast([]byte{1}, txtypes.BroadcastMode_BROADCAST_MODE_SYNC)
+		require.NoError(t, err)
+		assert.Equal(t, "HASH", res.TxResponse.TxHash)
+	})
+
+	t.Run("all rejected", func(t *testing.T) {
+		c := &Client{log: lggr, broadcasters: []*Client{node(unreachable), node(mempoolFull)}}
+		_, err := c.Broadcast([]byte{1}, txtypes.BroadcastMode_BROADCAST_MODE_SYNC)
+		assert.ErrorIs(t, err, ErrMempoolFull)
+		assert.ErrorIs(t, err, ErrTransient)
+	})
+
+	t.Run("single node", func(t *testing.T) {
+		c := &Client{log: lggr, broadcasters: []*Client{node(inCache)}}
+		_, err := c.Broadcast([]byte{1}, txtypes.BroadcastMode_BROADCAST_MODE_SYNC)
+		assert.ErrorIs(t, err, ErrTxInMempoolCache)
+	})
+}
@@ -0,0 +1,96 @@
+package client
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// DefaultTxsEventsPageLimit is the page size used by TxsEventsIterator when none is given.
+const DefaultTxsEventsPageLimit = 100
+
+// TxsEventsIterator walks all pages of txs matching a TxsEventsPage query, e.g.:
+//
+//	it := NewTxsEventsIterator(reader, events, txtypes.OrderBy_ORDER_BY_DESC, 0)
+//	for it.Next() {
+//		tx := it.TxResponse()
+//	}
+//	if err := it.Err(); err != nil {
+type TxsEventsIterator struct {
+	reader  Reader
+	events  []string
+	orderBy txtypes.OrderBy
+	limit   uint64
+
+	page    uint64 // last page fetched
+	fetched uint64
+	total   uint64
+	buf     []*sdk.TxResponse
+	cur     *sdk.TxResponse
+	err     error
+}
+
+// NewTxsEventsIterator returns an iterator over the txs matching events, in orderBy order, fetching limit txs per page.
+func NewTxsEventsIterator(reader Reader, events []string, orderBy txtypes.OrderBy, limit uint64) *TxsEventsIterator {
+	if limit == 0 {
+		limit = DefaultTxsEventsPageLimit
+	}
+	return &TxsEventsIterator{
+		reader:  reader,
+		events:  events,
+		orderBy: orderBy,
+		limit:   limit,
+	}
+}
+
+// Next advances to the next tx, fetching the next page if necessary.
+// It returns false once all txs have been visited, or an error occurred.
+func (it *TxsEventsIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if len(it.buf) == 0 {
+		if it.page > 0 && it.fetched >= it.total {
+			return false
+		}
+		it.page++
+		resp, err := it.reader.TxsEventsPage(it.events, it.orderBy, it.page, it.limit)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.buf, it.total = resp.TxResponses, resp.Total
+		it.fetched += uint64(len(resp.TxResponses))
+		if len(it.buf) == 0 {
+			return false
+		}
+	}
+	it.cur, it.buf = it.buf[0], it.buf[1:]
+	return true
+}
+
+// TxResponse returns the current tx.
+func (it *TxsEventsIterator) TxResponse() *sdk.TxResponse {
+	return it.cur
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (it *TxsEventsIterator) Err() error {
+	return it.err
+}
+
+// TxEvents returns the events emitted by tx.
+// Since SDK v0.47 all events are included in the flat Events field, while older versions only populate the per msg Logs.
+func TxEvents(tx *sdk.TxResponse) []sdk.StringEvent {
+	if len(tx.Events) > 0 {
+		events := make([]sdk.StringEvent, 0, len(tx.Events))
+		for _, e := range tx.Events {
+			events = append(events, sdk.StringifyEvent(e))
+		}
+		return events
+	}
+	var events []sdk.StringEvent
+	for _, log := range tx.Logs {
+		events = append(events, log.Events...)
+	}
+	return events
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"fmt"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pagesReader serves TxsEventsPage from txs.
+type pagesReader struct {
+	Reader
+	txs   []*sdk.TxResponse
+	pages []uint64
+	err   error
+}
+
+func (r *pagesReader) TxsEventsPage(events []string, orderBy txtypes.OrderBy, page, limit uint64) (*txtypes.GetTxsEventResponse, error) {
+	r.pages = append(r.pages, page)
+	if r.err != nil {
+		return nil, r.err
+	}
+	start := min((page-1)*limit, uint64(len(r.txs)))
+	end := min(start+limit, uint64(len(r.txs)))
+	return &txtypes.GetTxsEventResponse{TxResponses: r.txs[start:end], Total: uint64(len(r.txs))}, nil
+}
+
+func TestTxsEventsIterator(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		txs   int
+		limit uint64
+		pages []uint64
+	}{
+		{name: "empty", txs: 0, limit: 2, pages: []uint64{1}},
+		{name: "single page", txs: 2, limit: 3, pages: []uint64{1}},
+		{name: "full pages", txs: 4, limit: 2, pages: []uint64{1, 2}},
+		{name: "partial page", txs: 5, limit: 2, pages: []uint64{1, 2, 3}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &pagesReader{}
+			for i := 0; i < tt.txs; i++ {
+				r.txs = append(r.txs, &sdk.TxResponse{TxHash: fmt.Sprint(i)})
+			}
+			it := NewTxsEventsIterator(r, []string{"tx.height=1"}, txtypes.OrderBy_ORDER_BY_ASC, tt.limit)
+			var got []string
+			for it.Next() {
+				got = append(got, it.TxResponse().TxHash)
+			}
+			require.NoError(t, it.Err())
+			require.Len(t, got, tt.txs)
+			for i, hash := range got {
+				assert.Equal(t, fmt.Sprint(i), hash)
+			}
+			assert.Equal(t, tt.pages, r.pages)
+		})
+	}
+
+	t.Run("error", func(t *testing.T) {
+		r := &pagesReader{err: fmt.Errorf("rpc error")}
+		it := NewTxsEventsIterator(r, []string{"tx.height=1"}, txtypes.OrderBy_ORDER_BY_ASC, 0)
+		require.False(t, it.Next())
+		require.EqualError(t, it.Err(), "rpc error")
+		require.False(t, it.Next())
+		assert.Equal(t, []uint64{1}, r.pages)
+	})
+}
+
+func TestTxEvents(t *testing.T) {
+	logs := sdk.ABCIMessageLogs{
+		{MsgIndex: 0, Events: sdk.StringEvents{{Type: "message", Attributes: []sdk.Attribute{{Key: "action", Value: "a"}}}}},
+		{MsgIndex: 1, Events: sdk.StringEvents{{Type: "wasm", Attributes: []sdk.Attribute{{Key: "k", Value: "v"}}}}},
+	}
+	t.Run("logs", func(t *testing.T) {
+		events := TxEvents(&sdk.TxResponse{Logs: logs})
+		require.Len(t, events, 2)
+		assert.Equal(t, "message", events[0].Type)
+		assert.Equal(t, "wasm", events[1].Type)
+	})
+	t.Run("events", func(t *testing.T) {
+		events := TxEvents(&sdk.TxResponse{Logs: logs, Events: []abci.Event{
+			{Type: "tx", Attributes: []abci.EventAttribute{{Key: "fee", Value: "1ucosm"}}},
+			{Type: "wasm", Attributes: []abci.EventAttribute{{Key: "k", Value: "v"}}},
+		}})
+		require.Len(t, events, 2)
+		assert.Equal(t, "tx", events[0].Type)
+		assert.Equal(t, sdk.StringEvent{Type: "wasm", Attributes: []sdk.Attribute{{Key: "k", Value: "v"}}}, events[1])
+	})
+}
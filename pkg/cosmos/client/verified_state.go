@@ -0,0 +1,91 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LightBlockVerifier returns light blocks which have been verified independently of the node serving queries.
+// It is implemented by the cometbft light client.
+type LightBlockVerifier interface {
+	VerifyLightBlockAtHeight(ctx context.Context, height int64, now time.Time) (*cmttypes.LightBlock, error)
+}
+
+// VerifiedStateReader reads raw contract state along with its merkle proof, and verifies the proof against the
+// app hash of a light block from a LightBlockVerifier. Reads are only as trustworthy as the verifier,
+// rather than the node serving them.
+type VerifiedStateReader struct {
+	node     rpcclient.ABCIClient
+	verifier LightBlockVerifier
+	prt      *merkle.ProofRuntime
+}
+
+// NewVerifiedStateReader returns a VerifiedStateReader querying node, e.g. Reader.Context().Client.
+func NewVerifiedStateReader(node rpcclient.ABCIClient, verifier LightBlockVerifier) *VerifiedStateReader {
+	return &VerifiedStateReader{
+		node:     node,
+		verifier: verifier,
+		prt:      rootmulti.DefaultProofRuntime(),
+	}
+}
+
+// RawContractState returns the value stored under key by contract, as of the block at height.
+// A nil value is returned if the key is proven to be absent.
+// Since the app hash of a block is included in the header of the next block, that header must exist,
+// and height must be greater than 1.
+func (r *VerifiedStateReader) RawContractState(ctx context.Context, contract sdk.AccAddress, key []byte, height int64) ([]byte, error) {
+	storeKey := append(wasmtypes.GetContractStorePrefix(contract), key...)
+	return r.storeValue(ctx, wasmtypes.StoreKey, storeKey, height)
+}
+
+// storeValue returns the verified value stored under key in the module store storeName, as of the block at height.
+func (r *VerifiedStateReader) storeValue(ctx context.Context, storeName string, key []byte, height int64) ([]byte, error) {
+	if height <= 1 {
+		return nil, fmt.Errorf("invalid height %d: proofs are only available for heights greater than 1", height)
+	}
+	res, err := r.node.ABCIQueryWithOptions(ctx, "/store/"+storeName+"/key", key, rpcclient.ABCIQueryOptions{Height: height, Prove: true})
+	if err != nil {
+		return nil, ClassifyError(err)
+	}
+	resp := res.Response
+	if !resp.IsOK() {
+		return nil, NewABCIError(resp.Codespace, resp.Code, resp.Log, fmt.Errorf("query failed with code %d: %s", resp.Code, resp.Log))
+	}
+	if resp.Height != height {
+		return nil, fmt.Errorf("queried height %d but got response for height %d", height, resp.Height)
+	}
+	if !bytes.Equal(resp.Key, key) {
+		return nil, fmt.Errorf("queried key %X but got response for key %X", key, resp.Key)
+	}
+	if resp.ProofOps == nil || len(resp.ProofOps.Ops) == 0 {
+		return nil, fmt.Errorf("no proof returned for key %X", key)
+	}
+
+	// the app hash resulting from executing block height is in the header of the next block
+	block, err := r.verifier.VerifyLightBlockAtHeight(ctx, height+1, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify header at height %d: %w", height+1, err)
+	}
+	kp := merkle.KeyPath{}.
+		AppendKey([]byte(storeName), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingHex)
+	if resp.Value == nil {
+		if err := r.prt.VerifyAbsence(resp.ProofOps, block.AppHash, kp.String()); err != nil {
+			return nil, fmt.Errorf("failed to verify absence proof for key %X at height %d: %w", key, height, err)
+		}
+		return nil, nil
+	}
+	if err := r.prt.VerifyValue(resp.ProofOps, block.AppHash, kp.String(), resp.Value); err != nil {
+		return nil, fmt.Errorf("failed to verify proof for key %X at height %d: %w", key, height, err)
+	}
+	return resp.Value, nil
+}
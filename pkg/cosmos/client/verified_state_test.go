@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/libs/log"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+)
+
+// storeNode serves proven ABCI queries from an in-memory multistore.
+type storeNode struct {
+	rpcclient.ABCIClient
+	store    *rootmulti.Store
+	appHash  map[int64][]byte // header height => app hash
+	tampered bool
+}
+
+func newStoreNode(t *testing.T) *storeNode {
+	store := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	store.MountStoreWithDB(storetypes.NewKVStoreKey(wasmtypes.StoreKey), storetypes.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(storetypes.NewKVStoreKey("bank"), storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadLatestVersion())
+	return &storeNode{store: store, appHash: map[int64][]byte{}}
+}
+
+// commit sets kvs in the wasm store and commits a block.
+func (n *storeNode) commit(kvs map[string]string) {
+	kv := n.store.GetCommitKVStore(n.store.StoreKeysByName()[wasmtypes.StoreKey])
+	for k, v := range kvs {
+		kv.Set([]byte(k), []byte(v))
+	}
+	id := n.store.Commit()
+	n.appHash[id.Version+1] = id.Hash
+}
+
+func (n *storeNode) ABCIQueryWithOptions(_ context.Context, path string, data bytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error) {
+	resp := n.store.Query(abci.RequestQuery{Path: path[len("/store"):], Data: data, Height: opts.Height, Prove: opts.Prove})
+	if n.tampered && resp.Value != nil {
+		resp.Value = append([]byte("x"), resp.Value...)
+	}
+	return &coretypes.ResultABCIQuery{Response: resp}, nil
+}
+
+func (n *storeNode) VerifyLightBlockAtHeight(_ context.Context, height int64, _ time.Time) (*cmttypes.LightBlock, error) {
+	return &cmttypes.LightBlock{SignedHeader: &cmttypes.SignedHeader{Header: &cmttypes.Header{Height: height, AppHash: n.appHash[height]}}}, nil
+}
+
+func TestVerifiedStateReader(t *testing.T) {
+	contract := sdk.AccAddress("contract____________")
+	storeKey := func(key string) string {
+		return string(append(wasmtypes.GetContractStorePrefix(contract), key...))
+	}
+	node := newStoreNode(t)
+	node.commit(map[string]string{storeKey("config"): "v1"})
+	node.commit(map[string]string{storeKey("config"): "v2"})
+	node.commit(map[string]string{storeKey("other"): "v3"})
+	reader := NewVerifiedStateReader(node, node)
+
+	value, err := reader.RawContractState(tests.Context(t), contract, []byte("config"), 2)
+	require.NoError(t, err)
+	assert.Equal(t, "v2", string(value))
+	value, err = reader.RawContractState(tests.Context(t), contract, []byte("other"), 3)
+	require.NoError(t, err)
+	assert.Equal(t, "v3", string(value))
+
+	// absent
+	value, err = reader.RawContractState(tests.Context(t), contract, []byte("other"), 2)
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	_, err = reader.RawContractState(tests.Context(t), contract, []byte("config"), 1)
+	require.ErrorContains(t, err, "invalid height")
+
+	// the app hash of another block
+	node.appHash[3] = node.appHash[4]
+	_, err = reader.RawContractState(tests.Context(t), contract, []byte("config"), 2)
+	require.ErrorContains(t, err, "failed to verify proof")
+
+	node.tampered = true
+	_, err = reader.RawContractState(tests.Context(t), contract, []byte("config"), 3)
+	require.ErrorContains(t, err, "failed to verify proof")
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"encoding/hex"
 	"fmt"
 	"net/url"
 	"slices"
@@ -17,22 +18,65 @@ import (
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/db"
 )
 
+// Chain families, which select the adapters serving OCR2 jobs along with the ContractKind. See adapters.Register.
+const (
+	ChainFamilyCosmWasm  = "cosmwasm"
+	ChainFamilyInjective = "injective"
+)
+
+// ChainFamilies are the valid ChainFamily settings.
+var ChainFamilies = []string{ChainFamilyCosmWasm, ChainFamilyInjective}
+
+// Contract kinds, which select how OCR2 is implemented onchain.
+const (
+	// ContractKindCosmWasm is the OCR2 CosmWasm contracts.
+	ContractKindCosmWasm = "cosmwasm"
+	// ContractKindModule is the native OCR module of the chain, like that of Injective.
+	ContractKindModule = "module"
+)
+
+// ContractKinds are the valid ContractKind settings.
+var ContractKinds = []string{ContractKindCosmWasm, ContractKindModule}
+
+// injectivePrefix is the Bech32Prefix of Injective, which implies ChainFamilyInjective when ChainFamily is unset.
+const injectivePrefix = "inj"
+
 // Global defaults.
 var defaultConfigSet = configSet{
-	BlockRate: 6 * time.Second,
+	BalancePollPeriod: time.Minute,
+	BlockRate:         6 * time.Second,
 	// ~6s per block, so ~3m until we give up on the tx getting confirmed
 	// Anecdotally it appears anything more than 4 blocks would be an extremely long wait,
 	// In practice during the UST depegging and subsequent extreme congestion, we saw
 	// ~16 block FIFO lineups.
 	BlocksUntilTxTimeout: 30,
-	ConfirmPollPeriod:    time.Second,
-	FallbackGasPrice:     sdk.MustNewDecFromStr("0.015"),
+	// Txs are broadcast to a single node by default.
+	BroadcastFanOut:   false,
+	ConfirmPollPeriod: time.Second,
+	FallbackGasPrice:  sdk.MustNewDecFromStr("0.015"),
+	// Fees are not capped by default.
+	FeeBudget:       sdk.ZeroDec(),
+	FeeBudgetPeriod: 24 * time.Hour,
+	MaxFeePerTx:     sdk.ZeroDec(),
 	// This is high since we simulate before signing the transaction.
 	// There's a chicken and egg problem: need to sign to simulate accurately
 	// but you need to specify a gas limit when signing.
 	// TODO: Determine how much gas a signature adds and then
 	// add that directly so we can be more accurate.
 	GasLimitMultiplier: client.DefaultGasLimitMultiplier,
+	// Dynamic gas prices are disabled by default, so FallbackGasPrice is always used.
+	GasPriceSource:     "",
+	GasPriceMultiplier: sdk.OneDec(),
+	MinGasPrice:        sdk.ZeroDec(),
+	MaxGasPrice:        sdk.ZeroDec(),
+	// Sampling recent blocks is disabled by default.
+	GasPricePercentileBlocks: 0,
+	GasPricePercentile:       60,
+	GasPricePercentileTTL:    30 * time.Second,
+	// Dynamic and percentile gas prices are averaged when both are enabled.
+	GasPriceSourceWeight:     sdk.OneDec(),
+	GasPricePercentileWeight: sdk.OneDec(),
+	GasPriceMaxAge:           time.Minute,
 	// The max gas limit per block is 1_000_000_000
 	// https://github.com/terra-money/core/blob/d6037b9a12c8bf6b09fe861c8ad93456aac5eebb/app/legacy/migrate.go#L69.
 	// The max msg size is 10KB https://github.com/terra-money/core/blob/d6037b9a12c8bf6b09fe861c8ad93456aac5eebb/x/wasm/types/params.go#L15.
@@ -42,58 +86,201 @@ var defaultConfigSet = configSet{
 	// To be conservative and since the number of messages we'd
 	// have in a batch on average roughly corresponds to the number of terra ocr jobs we're running (do not expect more than 100),
 	// we can set a max msgs per batch of 100.
-	MaxMsgsPerBatch:     100,
-	OCR2CachePollPeriod: 4 * time.Second,
-	OCR2CacheTTL:        time.Minute,
-	TxMsgTimeout:        10 * time.Minute,
-	Bech32Prefix:        "wasm",  // note: this shouldn't be used outside of tests
-	GasToken:            "ucosm", // note: this shouldn't be used outside of tests
+	MaxMsgsPerBatch:        100,
+	OCR2CachePollPeriod:    4 * time.Second,
+	OCR2CacheTTL:           time.Minute,
+	RequestRetryAttempts:   int64(client.DefaultRetryConfig.Attempts),
+	RequestRetryMinBackoff: client.DefaultRetryConfig.MinBackoff,
+	RequestRetryMaxBackoff: client.DefaultRetryConfig.MaxBackoff,
+	TxMsgTimeout:           10 * time.Minute,
+	Bech32Prefix:           "wasm",  // note: this shouldn't be used outside of tests
+	GasToken:               "ucosm", // note: this shouldn't be used outside of tests
+	// The light client is disabled by default, since it requires a trusted header.
+	LightClientTrustedHeight: 0,
+	LightClientTrustedHash:   "",
+	LightClientTrustPeriod:   client.DefaultLightClientTrustPeriod,
+	LightClientVerifiedReads: false,
+	LightClientPersistTrust:  false,
+	// Low balances are not reported by default.
+	LowBalanceThreshold: sdk.ZeroDec(),
 }
 
 type Config interface {
+	BalancePollPeriod() time.Duration
 	Bech32Prefix() string
 	BlockRate() time.Duration
 	BlocksUntilTxTimeout() int64
+	BroadcastFanOut() bool
+	ChainFamily() string
 	ConfirmPollPeriod() time.Duration
+	ContractKind() string
 	FallbackGasPrice() sdk.Dec
+	FeeBudget() sdk.Dec
+	FeeBudgetPeriod() time.Duration
+	FeeDenoms() []string
 	GasToken() string
 	GasLimitMultiplier() float64
+	GasPriceSource() string
+	GasPriceMaxAge() time.Duration
+	GasPriceMultiplier() sdk.Dec
+	GasPricePercentile() int64
+	GasPricePercentileBlocks() int64
+	GasPricePercentileTTL() time.Duration
+	GasPricePercentileWeight() sdk.Dec
+	GasPriceSourceWeight() sdk.Dec
+	LightClientTrustedHeight() int64
+	LightClientTrustedHash() string
+	LightClientTrustPeriod() time.Duration
+	LightClientVerifiedReads() bool
+	LightClientPersistTrust() bool
+	LowBalanceThreshold() sdk.Dec
+	MaxFeePerTx() sdk.Dec
+	MaxGasPrice() sdk.Dec
 	MaxMsgsPerBatch() int64
+	MinGasPrice() sdk.Dec
 	OCR2CachePollPeriod() time.Duration
 	OCR2CacheTTL() time.Duration
+	RequestRetryAttempts() int64
+	RequestRetryMinBackoff() time.Duration
+	RequestRetryMaxBackoff() time.Duration
 	TxMsgTimeout() time.Duration
 }
 
 // opt: remove
 type configSet struct {
+	// BalancePollPeriod is how often the gas token balances of the keystore accounts are read.
+	BalancePollPeriod    time.Duration
 	Bech32Prefix         string
 	BlockRate            time.Duration
 	BlocksUntilTxTimeout int64
-	ConfirmPollPeriod    time.Duration
-	FallbackGasPrice     sdk.Dec
-	GasToken             string
-	GasLimitMultiplier   float64
-	MaxMsgsPerBatch      int64
-	OCR2CachePollPeriod  time.Duration
-	OCR2CacheTTL         time.Duration
-	TxMsgTimeout         time.Duration
+	// BroadcastFanOut broadcasts each tx to all healthy nodes serving broadcasts in parallel, rather than to one node.
+	BroadcastFanOut bool
+	// ChainFamily selects the adapters serving OCR2 jobs. Defaults to ChainFamilyInjective for the "inj" Bech32Prefix,
+	// and to ChainFamilyCosmWasm otherwise.
+	ChainFamily       string
+	ConfirmPollPeriod time.Duration
+	// ContractKind selects the OCR2 implementation served by the adapters. Defaults to ContractKindModule for
+	// ChainFamilyInjective, and to ContractKindCosmWasm otherwise.
+	ContractKind     string
+	FallbackGasPrice sdk.Dec
+	// FeeBudget caps the fees paid by each sender within the last FeeBudgetPeriod, in GasToken. Zero means no budget.
+	FeeBudget       sdk.Dec
+	FeeBudgetPeriod time.Duration
+	// FeeDenoms are the denoms fees may be paid in, by order of preference. Defaults to GasToken.
+	FeeDenoms          []string
+	GasToken           string
+	GasLimitMultiplier float64
+	// GasPriceSource enables dynamic gas prices, see client.GasPriceSources.
+	GasPriceSource     string
+	GasPriceMultiplier sdk.Dec
+	// GasPricePercentile is the percentile of the sampled fees per gas to estimate, from 0 to 100.
+	GasPricePercentile int64
+	// GasPricePercentileBlocks enables estimating gas prices from the fees paid in that many recent blocks.
+	GasPricePercentileBlocks int64
+	// GasPricePercentileTTL is how long a percentile estimate is reused before sampling recent blocks again.
+	GasPricePercentileTTL time.Duration
+	// GasPriceSourceWeight and GasPricePercentileWeight weigh the average of dynamic and percentile gas prices,
+	// whose last good values are used for up to GasPriceMaxAge when they fail.
+	GasPriceSourceWeight     sdk.Dec
+	GasPricePercentileWeight sdk.Dec
+	GasPriceMaxAge           time.Duration
+	// LightClientTrustedHeight and LightClientTrustedHash enable the light client when set.
+	LightClientTrustedHeight int64
+	LightClientTrustedHash   string
+	LightClientTrustPeriod   time.Duration
+	// LightClientVerifiedReads verifies the OCR reads of contract config and transmission details with the
+	// light client, rather than trusting the node serving them.
+	LightClientVerifiedReads bool
+	// LightClientPersistTrust saves the latest verified header in the cosmos_light_client_headers table, so that
+	// restarts resume from it. The table is not migrated here, so the host must provide it.
+	LightClientPersistTrust bool
+	// LowBalanceThreshold marks the chain unhealthy while an account holds less of GasToken. Zero means no threshold.
+	LowBalanceThreshold sdk.Dec
+	// MaxFeePerTx caps the fee of each tx, in GasToken. Zero means no cap.
+	MaxFeePerTx sdk.Dec
+	// MinGasPrice and MaxGasPrice bound dynamic and percentile gas prices. A zero MaxGasPrice means no upper bound.
+	MaxGasPrice         sdk.Dec
+	MaxMsgsPerBatch     int64
+	MinGasPrice         sdk.Dec
+	OCR2CachePollPeriod time.Duration
+	OCR2CacheTTL        time.Duration
+	// RequestRetryAttempts bounds the attempts of node requests failing with a transient error, which are retried
+	// after a backoff from RequestRetryMinBackoff to RequestRetryMaxBackoff. Values below 2 disable retries.
+	RequestRetryAttempts   int64
+	RequestRetryMinBackoff time.Duration
+	RequestRetryMaxBackoff time.Duration
+	TxMsgTimeout           time.Duration
 }
 
 type Chain struct {
+	// BalancePollPeriod is how often the gas token balances of the keystore accounts are read.
+	BalancePollPeriod    *config.Duration
 	Bech32Prefix         *string
 	BlockRate            *config.Duration
 	BlocksUntilTxTimeout *int64
-	ConfirmPollPeriod    *config.Duration
-	FallbackGasPrice     *decimal.Decimal
-	GasToken             *string
-	GasLimitMultiplier   *decimal.Decimal
-	MaxMsgsPerBatch      *int64
-	OCR2CachePollPeriod  *config.Duration
-	OCR2CacheTTL         *config.Duration
-	TxMsgTimeout         *config.Duration
+	// BroadcastFanOut broadcasts each tx to all healthy nodes serving broadcasts in parallel, rather than to one node.
+	BroadcastFanOut *bool
+	// ChainFamily selects the adapters serving OCR2 jobs. Defaults to ChainFamilyInjective for the "inj" Bech32Prefix,
+	// and to ChainFamilyCosmWasm otherwise.
+	ChainFamily       *string
+	ConfirmPollPeriod *config.Duration
+	// ContractKind selects the OCR2 implementation served by the adapters. Defaults to ContractKindModule for
+	// ChainFamilyInjective, and to ContractKindCosmWasm otherwise.
+	ContractKind     *string
+	FallbackGasPrice *decimal.Decimal
+	// FeeBudget caps the fees paid by each sender within the last FeeBudgetPeriod, in GasToken. Zero means no budget.
+	FeeBudget       *decimal.Decimal
+	FeeBudgetPeriod *config.Duration
+	// FeeDenoms are the denoms fees may be paid in, by order of preference. Defaults to GasToken.
+	FeeDenoms          []string
+	GasToken           *string
+	GasLimitMultiplier *decimal.Decimal
+	// GasPriceSource enables dynamic gas prices, see client.GasPriceSources.
+	GasPriceSource     *string
+	GasPriceMultiplier *decimal.Decimal
+	// GasPricePercentile is the percentile of the sampled fees per gas to estimate, from 0 to 100.
+	GasPricePercentile *int64
+	// GasPricePercentileBlocks enables estimating gas prices from the fees paid in that many recent blocks.
+	GasPricePercentileBlocks *int64
+	// GasPricePercentileTTL is how long a percentile estimate is reused before sampling recent blocks again.
+	GasPricePercentileTTL *config.Duration
+	// GasPriceSourceWeight and GasPricePercentileWeight weigh the average of dynamic and percentile gas prices,
+	// whose last good values are used for up to GasPriceMaxAge when they fail.
+	GasPriceSourceWeight     *decimal.Decimal
+	GasPricePercentileWeight *decimal.Decimal
+	GasPriceMaxAge           *config.Duration
+	// LightClientTrustedHeight and LightClientTrustedHash enable the light client when set.
+	LightClientTrustedHeight *int64
+	LightClientTrustedHash   *string
+	LightClientTrustPeriod   *config.Duration
+	// LightClientVerifiedReads verifies the OCR reads of contract config and transmission details with the
+	// light client, rather than trusting the node serving them.
+	LightClientVerifiedReads *bool
+	// LightClientPersistTrust saves the latest verified header in the cosmos_light_client_headers table, so that
+	// restarts resume from it. The table is not migrated here, so the host must provide it.
+	LightClientPersistTrust *bool
+	// LowBalanceThreshold marks the chain unhealthy while an account holds less of GasToken. Zero means no threshold.
+	LowBalanceThreshold *decimal.Decimal
+	// MaxFeePerTx caps the fee of each tx, in GasToken. Zero means no cap.
+	MaxFeePerTx *decimal.Decimal
+	// MinGasPrice and MaxGasPrice bound dynamic and percentile gas prices. A zero MaxGasPrice means no upper bound.
+	MaxGasPrice         *decimal.Decimal
+	MaxMsgsPerBatch     *int64
+	MinGasPrice         *decimal.Decimal
+	OCR2CachePollPeriod *config.Duration
+	OCR2CacheTTL        *config.Duration
+	// RequestRetryAttempts bounds the attempts of node requests failing with a transient error, which are retried
+	// after a backoff from RequestRetryMinBackoff to RequestRetryMaxBackoff. Values below 2 disable retries.
+	RequestRetryAttempts   *int64
+	RequestRetryMinBackoff *config.Duration
+	RequestRetryMaxBackoff *config.Duration
+	TxMsgTimeout           *config.Duration
 }
 
 func (c *Chain) SetDefaults() {
+	if c.BalancePollPeriod == nil {
+		c.BalancePollPeriod = config.MustNewDuration(defaultConfigSet.BalancePollPeriod)
+	}
 	if c.Bech32Prefix == nil {
 		c.Bech32Prefix = &defaultConfigSet.Bech32Prefix
 	}
@@ -103,13 +290,29 @@ func (c *Chain) SetDefaults() {
 	if c.BlocksUntilTxTimeout == nil {
 		c.BlocksUntilTxTimeout = &defaultConfigSet.BlocksUntilTxTimeout
 	}
+	if c.BroadcastFanOut == nil {
+		c.BroadcastFanOut = &defaultConfigSet.BroadcastFanOut
+	}
+	if c.ChainFamily == nil {
+		c.ChainFamily = &defaultConfigSet.ChainFamily
+	}
 	if c.ConfirmPollPeriod == nil {
 		c.ConfirmPollPeriod = config.MustNewDuration(defaultConfigSet.ConfirmPollPeriod)
 	}
+	if c.ContractKind == nil {
+		c.ContractKind = &defaultConfigSet.ContractKind
+	}
 	if c.FallbackGasPrice == nil {
-		d := decimal.NewFromBigInt(defaultConfigSet.FallbackGasPrice.BigInt(), -sdk.Precision)
+		d := decimalFromSDKDec(defaultConfigSet.FallbackGasPrice)
 		c.FallbackGasPrice = &d
 	}
+	if c.FeeBudget == nil {
+		d := decimalFromSDKDec(defaultConfigSet.FeeBudget)
+		c.FeeBudget = &d
+	}
+	if c.FeeBudgetPeriod == nil {
+		c.FeeBudgetPeriod = config.MustNewDuration(defaultConfigSet.FeeBudgetPeriod)
+	}
 	if c.GasToken == nil {
 		c.GasToken = &defaultConfigSet.GasToken
 	}
@@ -117,23 +320,134 @@ func (c *Chain) SetDefaults() {
 		d := decimal.NewFromFloat(defaultConfigSet.GasLimitMultiplier)
 		c.GasLimitMultiplier = &d
 	}
+	if c.GasPriceSource == nil {
+		c.GasPriceSource = &defaultConfigSet.GasPriceSource
+	}
+	if c.GasPriceMultiplier == nil {
+		d := decimalFromSDKDec(defaultConfigSet.GasPriceMultiplier)
+		c.GasPriceMultiplier = &d
+	}
+	if c.GasPricePercentile == nil {
+		c.GasPricePercentile = &defaultConfigSet.GasPricePercentile
+	}
+	if c.GasPricePercentileBlocks == nil {
+		c.GasPricePercentileBlocks = &defaultConfigSet.GasPricePercentileBlocks
+	}
+	if c.GasPricePercentileTTL == nil {
+		c.GasPricePercentileTTL = config.MustNewDuration(defaultConfigSet.GasPricePercentileTTL)
+	}
+	if c.GasPriceSourceWeight == nil {
+		d := decimalFromSDKDec(defaultConfigSet.GasPriceSourceWeight)
+		c.GasPriceSourceWeight = &d
+	}
+	if c.GasPricePercentileWeight == nil {
+		d := decimalFromSDKDec(defaultConfigSet.GasPricePercentileWeight)
+		c.GasPricePercentileWeight = &d
+	}
+	if c.GasPriceMaxAge == nil {
+		c.GasPriceMaxAge = config.MustNewDuration(defaultConfigSet.GasPriceMaxAge)
+	}
+	if c.LightClientTrustedHeight == nil {
+		c.LightClientTrustedHeight = &defaultConfigSet.LightClientTrustedHeight
+	}
+	if c.LightClientTrustedHash == nil {
+		c.LightClientTrustedHash = &defaultConfigSet.LightClientTrustedHash
+	}
+	if c.LightClientTrustPeriod == nil {
+		c.LightClientTrustPeriod = config.MustNewDuration(defaultConfigSet.LightClientTrustPeriod)
+	}
+	if c.LightClientVerifiedReads == nil {
+		c.LightClientVerifiedReads = &defaultConfigSet.LightClientVerifiedReads
+	}
+	if c.LightClientPersistTrust == nil {
+		c.LightClientPersistTrust = &defaultConfigSet.LightClientPersistTrust
+	}
+	if c.LowBalanceThreshold == nil {
+		d := decimalFromSDKDec(defaultConfigSet.LowBalanceThreshold)
+		c.LowBalanceThreshold = &d
+	}
+	if c.MaxGasPrice == nil {
+		d := decimalFromSDKDec(defaultConfigSet.MaxGasPrice)
+		c.MaxGasPrice = &d
+	}
+	if c.MaxFeePerTx == nil {
+		d := decimalFromSDKDec(defaultConfigSet.MaxFeePerTx)
+		c.MaxFeePerTx = &d
+	}
 	if c.MaxMsgsPerBatch == nil {
 		c.MaxMsgsPerBatch = &defaultConfigSet.MaxMsgsPerBatch
 	}
+	if c.MinGasPrice == nil {
+		d := decimalFromSDKDec(defaultConfigSet.MinGasPrice)
+		c.MinGasPrice = &d
+	}
 	if c.OCR2CachePollPeriod == nil {
 		c.OCR2CachePollPeriod = config.MustNewDuration(defaultConfigSet.OCR2CachePollPeriod)
 	}
 	if c.OCR2CacheTTL == nil {
 		c.OCR2CacheTTL = config.MustNewDuration(defaultConfigSet.OCR2CacheTTL)
 	}
+	if c.RequestRetryAttempts == nil {
+		c.RequestRetryAttempts = &defaultConfigSet.RequestRetryAttempts
+	}
+	if c.RequestRetryMinBackoff == nil {
+		c.RequestRetryMinBackoff = config.MustNewDuration(defaultConfigSet.RequestRetryMinBackoff)
+	}
+	if c.RequestRetryMaxBackoff == nil {
+		c.RequestRetryMaxBackoff = config.MustNewDuration(defaultConfigSet.RequestRetryMaxBackoff)
+	}
 	if c.TxMsgTimeout == nil {
 		c.TxMsgTimeout = config.MustNewDuration(defaultConfigSet.TxMsgTimeout)
 	}
 }
 
+// Node roles, which select the requests a node serves.
+const (
+	// NodeRolePrimary nodes serve reads and broadcasts.
+	NodeRolePrimary = "primary"
+	// NodeRoleSendOnly nodes only serve broadcasts.
+	NodeRoleSendOnly = "sendonly"
+	// NodeRoleArchive nodes are primary nodes which keep historical state, and also serve height-pinned queries.
+	NodeRoleArchive = "archive"
+)
+
+var nodeRoles = []string{NodeRolePrimary, NodeRoleSendOnly, NodeRoleArchive}
+
+// Secret is a string which is redacted when marshaled, so that it is not exposed by TOMLString or node statuses.
+type Secret string
+
+const redacted = "xxxxx"
+
+func (s Secret) MarshalText() ([]byte, error) {
+	return []byte(redacted), nil
+}
+
+func (s *Secret) UnmarshalText(text []byte) error {
+	*s = Secret(text)
+	return nil
+}
+
 type Node struct {
 	Name          *string
 	TendermintURL *config.URL
+	// GRPCURL is the gRPC endpoint of the node. When set, queries are sent over gRPC rather than the Tendermint RPC.
+	GRPCURL *config.URL
+	// WebsocketURL overrides the /websocket endpoint of TendermintURL for event subscriptions.
+	WebsocketURL *config.URL
+	// RequestTimeout bounds each request to the node.
+	RequestTimeout *config.Duration
+	// RateLimit is the maximum number of requests per second sent to the node. Zero means no limit.
+	RateLimit *int64
+	// BasicAuthUser and BasicAuthPassword authenticate requests to hosted RPC providers.
+	BasicAuthUser     *string
+	BasicAuthPassword *Secret
+	// AuthHeaders are set on every request, like the API key header of a hosted RPC provider.
+	AuthHeaders map[string]Secret
+	// Role is one of primary, sendonly or archive. Defaults to primary.
+	Role *string
+	// Priority orders the nodes serving a request, lowest first. Requests are spread across the nodes of the
+	// lowest priority. Defaults to 0.
+	Priority *int64
 }
 
 func (n *Node) ValidateConfig() (err error) {
@@ -145,9 +459,72 @@ func (n *Node) ValidateConfig() (err error) {
 	if n.TendermintURL == nil {
 		err = multierr.Append(err, config.ErrMissing{Name: "TendermintURL", Msg: "required for all nodes"})
 	}
+	if u := (*url.URL)(n.GRPCURL); u != nil && u.Scheme != "http" && u.Scheme != "https" {
+		err = multierr.Append(err, config.ErrInvalid{Name: "GRPCURL", Value: u.String(), Msg: "must be an http or https URL"})
+	}
+	if u := (*url.URL)(n.WebsocketURL); u != nil && !slices.Contains([]string{"ws", "wss", "http", "https"}, u.Scheme) {
+		err = multierr.Append(err, config.ErrInvalid{Name: "WebsocketURL", Value: u.String(), Msg: "must be a ws, wss, http or https URL"})
+	}
+	if n.RequestTimeout != nil && n.RequestTimeout.Duration() <= 0 {
+		err = multierr.Append(err, config.ErrInvalid{Name: "RequestTimeout", Value: n.RequestTimeout.String(), Msg: "must be positive"})
+	}
+	if n.RateLimit != nil && *n.RateLimit < 0 {
+		err = multierr.Append(err, config.ErrInvalid{Name: "RateLimit", Value: *n.RateLimit, Msg: "must not be negative"})
+	}
+	if n.BasicAuthPassword != nil && (n.BasicAuthUser == nil || *n.BasicAuthUser == "") {
+		err = multierr.Append(err, config.ErrMissing{Name: "BasicAuthUser", Msg: "required with BasicAuthPassword"})
+	}
+	if n.Role != nil && !slices.Contains(nodeRoles, *n.Role) {
+		err = multierr.Append(err, config.ErrInvalid{Name: "Role", Value: *n.Role, Msg: fmt.Sprintf("must be one of %v", nodeRoles)})
+	}
+	if n.Priority != nil && *n.Priority < 0 {
+		err = multierr.Append(err, config.ErrInvalid{Name: "Priority", Value: *n.Priority, Msg: "must not be negative"})
+	}
 	return
 }
 
+// NodeRole returns the role of the node, which defaults to primary.
+func (n *Node) NodeRole() string {
+	if n.Role == nil {
+		return NodeRolePrimary
+	}
+	return *n.Role
+}
+
+// NodePriority returns the priority of the node, which defaults to 0.
+func (n *Node) NodePriority() int64 {
+	if n.Priority == nil {
+		return 0
+	}
+	return *n.Priority
+}
+
+// NodeRequestTimeout returns the request timeout of the node, which defaults to client.DefaultTimeout.
+func (n *Node) NodeRequestTimeout() time.Duration {
+	if n.RequestTimeout == nil {
+		return client.DefaultTimeout
+	}
+	return n.RequestTimeout.Duration()
+}
+
+// Auth returns the credentials of the node.
+func (n *Node) Auth() client.Auth {
+	var auth client.Auth
+	if n.BasicAuthUser != nil {
+		auth.BasicAuthUser = *n.BasicAuthUser
+	}
+	if n.BasicAuthPassword != nil {
+		auth.BasicAuthPassword = string(*n.BasicAuthPassword)
+	}
+	if len(n.AuthHeaders) > 0 {
+		auth.Headers = make(map[string]string, len(n.AuthHeaders))
+		for k, v := range n.AuthHeaders {
+			auth.Headers[k] = string(v)
+		}
+	}
+	return auth
+}
+
 type TOMLConfigs []*TOMLConfig
 
 func (cs TOMLConfigs) validateKeys() (err error) {
@@ -228,6 +605,33 @@ func setFromNode(n, f *Node) {
 	if f.TendermintURL != nil {
 		n.TendermintURL = f.TendermintURL
 	}
+	if f.GRPCURL != nil {
+		n.GRPCURL = f.GRPCURL
+	}
+	if f.WebsocketURL != nil {
+		n.WebsocketURL = f.WebsocketURL
+	}
+	if f.RequestTimeout != nil {
+		n.RequestTimeout = f.RequestTimeout
+	}
+	if f.RateLimit != nil {
+		n.RateLimit = f.RateLimit
+	}
+	if f.BasicAuthUser != nil {
+		n.BasicAuthUser = f.BasicAuthUser
+	}
+	if f.BasicAuthPassword != nil {
+		n.BasicAuthPassword = f.BasicAuthPassword
+	}
+	if f.AuthHeaders != nil {
+		n.AuthHeaders = f.AuthHeaders
+	}
+	if f.Role != nil {
+		n.Role = f.Role
+	}
+	if f.Priority != nil {
+		n.Priority = f.Priority
+	}
 }
 
 func legacyNode(n *Node, id string) db.Node {
@@ -262,6 +666,9 @@ func (c *TOMLConfig) SetFrom(f *TOMLConfig) {
 }
 
 func setFromChain(c, f *Chain) {
+	if f.BalancePollPeriod != nil {
+		c.BalancePollPeriod = f.BalancePollPeriod
+	}
 	if f.Bech32Prefix != nil {
 		c.Bech32Prefix = f.Bech32Prefix
 	}
@@ -271,27 +678,105 @@ func setFromChain(c, f *Chain) {
 	if f.BlocksUntilTxTimeout != nil {
 		c.BlocksUntilTxTimeout = f.BlocksUntilTxTimeout
 	}
+	if f.BroadcastFanOut != nil {
+		c.BroadcastFanOut = f.BroadcastFanOut
+	}
+	if f.ChainFamily != nil {
+		c.ChainFamily = f.ChainFamily
+	}
 	if f.ConfirmPollPeriod != nil {
 		c.ConfirmPollPeriod = f.ConfirmPollPeriod
 	}
+	if f.ContractKind != nil {
+		c.ContractKind = f.ContractKind
+	}
 	if f.FallbackGasPrice != nil {
 		c.FallbackGasPrice = f.FallbackGasPrice
 	}
+	if f.FeeBudget != nil {
+		c.FeeBudget = f.FeeBudget
+	}
+	if f.FeeBudgetPeriod != nil {
+		c.FeeBudgetPeriod = f.FeeBudgetPeriod
+	}
+	if f.FeeDenoms != nil {
+		c.FeeDenoms = f.FeeDenoms
+	}
 	if f.GasToken != nil {
 		c.GasToken = f.GasToken
 	}
 	if f.GasLimitMultiplier != nil {
 		c.GasLimitMultiplier = f.GasLimitMultiplier
 	}
+	if f.GasPriceSource != nil {
+		c.GasPriceSource = f.GasPriceSource
+	}
+	if f.GasPriceMultiplier != nil {
+		c.GasPriceMultiplier = f.GasPriceMultiplier
+	}
+	if f.GasPricePercentile != nil {
+		c.GasPricePercentile = f.GasPricePercentile
+	}
+	if f.GasPricePercentileBlocks != nil {
+		c.GasPricePercentileBlocks = f.GasPricePercentileBlocks
+	}
+	if f.GasPricePercentileTTL != nil {
+		c.GasPricePercentileTTL = f.GasPricePercentileTTL
+	}
+	if f.GasPriceSourceWeight != nil {
+		c.GasPriceSourceWeight = f.GasPriceSourceWeight
+	}
+	if f.GasPricePercentileWeight != nil {
+		c.GasPricePercentileWeight = f.GasPricePercentileWeight
+	}
+	if f.GasPriceMaxAge != nil {
+		c.GasPriceMaxAge = f.GasPriceMaxAge
+	}
+	if f.LightClientTrustedHeight != nil {
+		c.LightClientTrustedHeight = f.LightClientTrustedHeight
+	}
+	if f.LightClientTrustedHash != nil {
+		c.LightClientTrustedHash = f.LightClientTrustedHash
+	}
+	if f.LightClientTrustPeriod != nil {
+		c.LightClientTrustPeriod = f.LightClientTrustPeriod
+	}
+	if f.LightClientVerifiedReads != nil {
+		c.LightClientVerifiedReads = f.LightClientVerifiedReads
+	}
+	if f.LightClientPersistTrust != nil {
+		c.LightClientPersistTrust = f.LightClientPersistTrust
+	}
+	if f.LowBalanceThreshold != nil {
+		c.LowBalanceThreshold = f.LowBalanceThreshold
+	}
+	if f.MaxGasPrice != nil {
+		c.MaxGasPrice = f.MaxGasPrice
+	}
+	if f.MaxFeePerTx != nil {
+		c.MaxFeePerTx = f.MaxFeePerTx
+	}
 	if f.MaxMsgsPerBatch != nil {
 		c.MaxMsgsPerBatch = f.MaxMsgsPerBatch
 	}
+	if f.MinGasPrice != nil {
+		c.MinGasPrice = f.MinGasPrice
+	}
 	if f.OCR2CachePollPeriod != nil {
 		c.OCR2CachePollPeriod = f.OCR2CachePollPeriod
 	}
 	if f.OCR2CacheTTL != nil {
 		c.OCR2CacheTTL = f.OCR2CacheTTL
 	}
+	if f.RequestRetryAttempts != nil {
+		c.RequestRetryAttempts = f.RequestRetryAttempts
+	}
+	if f.RequestRetryMinBackoff != nil {
+		c.RequestRetryMinBackoff = f.RequestRetryMinBackoff
+	}
+	if f.RequestRetryMaxBackoff != nil {
+		c.RequestRetryMaxBackoff = f.RequestRetryMaxBackoff
+	}
 	if f.TxMsgTimeout != nil {
 		c.TxMsgTimeout = f.TxMsgTimeout
 	}
@@ -306,6 +791,107 @@ func (c *TOMLConfig) ValidateConfig() (err error) {
 
 	if len(c.Nodes) == 0 {
 		err = multierr.Append(err, config.ErrMissing{Name: "Nodes", Msg: "must have at least one node"})
+	} else if !slices.ContainsFunc(c.Nodes, func(n *Node) bool { return n.NodeRole() != NodeRoleSendOnly }) {
+		err = multierr.Append(err, config.ErrInvalid{Name: "Nodes", Value: len(c.Nodes), Msg: "must have at least one node which is not send-only"})
+	}
+
+	height, hash := c.Chain.LightClientTrustedHeight, c.Chain.LightClientTrustedHash
+	if height != nil && *height < 0 {
+		err = multierr.Append(err, config.ErrInvalid{Name: "LightClientTrustedHeight", Value: *height, Msg: "must not be negative"})
+	}
+	if hash != nil && *hash != "" {
+		if b, hexErr := hex.DecodeString(*hash); hexErr != nil || len(b) != 32 {
+			err = multierr.Append(err, config.ErrInvalid{Name: "LightClientTrustedHash", Value: *hash, Msg: "must be a 32 byte hex encoded hash"})
+		}
+	}
+	hasHeight, hasHash := height != nil && *height > 0, hash != nil && *hash != ""
+	if hasHeight && !hasHash {
+		err = multierr.Append(err, config.ErrMissing{Name: "LightClientTrustedHash", Msg: "required with LightClientTrustedHeight"})
+	} else if hasHash && !hasHeight {
+		err = multierr.Append(err, config.ErrMissing{Name: "LightClientTrustedHeight", Msg: "required with LightClientTrustedHash"})
+	}
+	if verified := c.Chain.LightClientVerifiedReads; verified != nil && *verified && !hasHeight {
+		err = multierr.Append(err, config.ErrMissing{Name: "LightClientTrustedHeight", Msg: "required with LightClientVerifiedReads"})
+	}
+	if persist := c.Chain.LightClientPersistTrust; persist != nil && *persist && !hasHeight {
+		err = multierr.Append(err, config.ErrMissing{Name: "LightClientTrustedHeight", Msg: "required with LightClientPersistTrust"})
+	}
+
+	if family := c.Chain.ChainFamily; family != nil && *family != "" && !slices.Contains(ChainFamilies, *family) {
+		err = multierr.Append(err, config.ErrInvalid{Name: "ChainFamily", Value: *family, Msg: fmt.Sprintf("must be one of %v", ChainFamilies)})
+	}
+	if kind := c.Chain.ContractKind; kind != nil && *kind != "" && !slices.Contains(ContractKinds, *kind) {
+		err = multierr.Append(err, config.ErrInvalid{Name: "ContractKind", Value: *kind, Msg: fmt.Sprintf("must be one of %v", ContractKinds)})
+	}
+	if source := c.Chain.GasPriceSource; source != nil && *source != "" && !slices.Contains(client.GasPriceSources, *source) {
+		err = multierr.Append(err, config.ErrInvalid{Name: "GasPriceSource", Value: *source, Msg: fmt.Sprintf("must be one of %v", client.GasPriceSources)})
+	}
+	if m := c.Chain.GasPriceMultiplier; m != nil && !m.IsPositive() {
+		err = multierr.Append(err, config.ErrInvalid{Name: "GasPriceMultiplier", Value: m.String(), Msg: "must be positive"})
+	}
+	if b := c.Chain.FeeBudget; b != nil && b.IsNegative() {
+		err = multierr.Append(err, config.ErrInvalid{Name: "FeeBudget", Value: b.String(), Msg: "must not be negative"})
+	}
+	if p := c.Chain.FeeBudgetPeriod; p != nil && p.Duration() <= 0 {
+		err = multierr.Append(err, config.ErrInvalid{Name: "FeeBudgetPeriod", Value: p.String(), Msg: "must be positive"})
+	}
+	if p := c.Chain.BalancePollPeriod; p != nil && p.Duration() <= 0 {
+		err = multierr.Append(err, config.ErrInvalid{Name: "BalancePollPeriod", Value: p.String(), Msg: "must be positive"})
+	}
+	if t := c.Chain.LowBalanceThreshold; t != nil && t.IsNegative() {
+		err = multierr.Append(err, config.ErrInvalid{Name: "LowBalanceThreshold", Value: t.String(), Msg: "must not be negative"})
+	}
+	if m := c.Chain.MaxFeePerTx; m != nil && m.IsNegative() {
+		err = multierr.Append(err, config.ErrInvalid{Name: "MaxFeePerTx", Value: m.String(), Msg: "must not be negative"})
+	}
+	seen := map[string]bool{}
+	for _, d := range c.Chain.FeeDenoms {
+		if denomErr := sdk.ValidateDenom(d); denomErr != nil {
+			err = multierr.Append(err, config.ErrInvalid{Name: "FeeDenoms", Value: d, Msg: denomErr.Error()})
+		} else if seen[d] {
+			err = multierr.Append(err, config.ErrInvalid{Name: "FeeDenoms", Value: d, Msg: "must not be duplicated"})
+		}
+		seen[d] = true
+	}
+	// Fees are capped in GasToken, and other denoms like IBC assets have no known conversion to it.
+	maxFee, budget := c.Chain.MaxFeePerTx, c.Chain.FeeBudget
+	if capped := (maxFee != nil && maxFee.IsPositive()) || (budget != nil && budget.IsPositive()); capped && c.Chain.GasToken != nil {
+		for _, d := range c.Chain.FeeDenoms {
+			if d != *c.Chain.GasToken {
+				err = multierr.Append(err, config.ErrInvalid{Name: "FeeDenoms", Value: d, Msg: "must only contain GasToken when MaxFeePerTx or FeeBudget is set"})
+			}
+		}
+	}
+	if p := c.Chain.GasPricePercentile; p != nil && (*p < 0 || *p > 100) {
+		err = multierr.Append(err, config.ErrInvalid{Name: "GasPricePercentile", Value: *p, Msg: "must be between 0 and 100"})
+	}
+	if b := c.Chain.GasPricePercentileBlocks; b != nil && *b < 0 {
+		err = multierr.Append(err, config.ErrInvalid{Name: "GasPricePercentileBlocks", Value: *b, Msg: "must not be negative"})
+	}
+	if w := c.Chain.GasPriceSourceWeight; w != nil && !w.IsPositive() {
+		err = multierr.Append(err, config.ErrInvalid{Name: "GasPriceSourceWeight", Value: w.String(), Msg: "must be positive"})
+	}
+	if w := c.Chain.GasPricePercentileWeight; w != nil && !w.IsPositive() {
+		err = multierr.Append(err, config.ErrInvalid{Name: "GasPricePercentileWeight", Value: w.String(), Msg: "must be positive"})
+	}
+	minPrice, maxPrice := c.Chain.MinGasPrice, c.Chain.MaxGasPrice
+	if minPrice != nil && minPrice.IsNegative() {
+		err = multierr.Append(err, config.ErrInvalid{Name: "MinGasPrice", Value: minPrice.String(), Msg: "must not be negative"})
+	}
+	if maxPrice != nil && maxPrice.IsNegative() {
+		err = multierr.Append(err, config.ErrInvalid{Name: "MaxGasPrice", Value: maxPrice.String(), Msg: "must not be negative"})
+	} else if maxPrice != nil && minPrice != nil && maxPrice.IsPositive() && maxPrice.LessThan(*minPrice) {
+		err = multierr.Append(err, config.ErrInvalid{Name: "MaxGasPrice", Value: maxPrice.String(), Msg: "must not be less than MinGasPrice"})
+	}
+	if a := c.Chain.RequestRetryAttempts; a != nil && *a < 1 {
+		err = multierr.Append(err, config.ErrInvalid{Name: "RequestRetryAttempts", Value: *a, Msg: "must be positive"})
+	}
+	minBackoff, maxBackoff := c.Chain.RequestRetryMinBackoff, c.Chain.RequestRetryMaxBackoff
+	if minBackoff != nil && minBackoff.Duration() <= 0 {
+		err = multierr.Append(err, config.ErrInvalid{Name: "RequestRetryMinBackoff", Value: minBackoff.String(), Msg: "must be positive"})
+	}
+	if maxBackoff != nil && minBackoff != nil && maxBackoff.Duration() < minBackoff.Duration() {
+		err = multierr.Append(err, config.ErrInvalid{Name: "RequestRetryMaxBackoff", Value: maxBackoff.String(), Msg: "must not be less than RequestRetryMinBackoff"})
 	}
 
 	return
@@ -321,6 +907,10 @@ func (c *TOMLConfig) TOMLString() (string, error) {
 
 var _ Config = &TOMLConfig{}
 
+func (c *TOMLConfig) BalancePollPeriod() time.Duration {
+	return c.Chain.BalancePollPeriod.Duration()
+}
+
 func (c *TOMLConfig) Bech32Prefix() string {
 	return *c.Chain.Bech32Prefix
 }
@@ -333,14 +923,53 @@ func (c *TOMLConfig) BlocksUntilTxTimeout() int64 {
 	return *c.Chain.BlocksUntilTxTimeout
 }
 
+func (c *TOMLConfig) BroadcastFanOut() bool {
+	return *c.Chain.BroadcastFanOut
+}
+
+func (c *TOMLConfig) ChainFamily() string {
+	if family := *c.Chain.ChainFamily; family != "" {
+		return family
+	}
+	if c.Bech32Prefix() == injectivePrefix {
+		return ChainFamilyInjective
+	}
+	return ChainFamilyCosmWasm
+}
+
 func (c *TOMLConfig) ConfirmPollPeriod() time.Duration {
 	return c.Chain.ConfirmPollPeriod.Duration()
 }
 
+func (c *TOMLConfig) ContractKind() string {
+	if kind := *c.Chain.ContractKind; kind != "" {
+		return kind
+	}
+	if c.ChainFamily() == ChainFamilyInjective {
+		return ContractKindModule
+	}
+	return ContractKindCosmWasm
+}
+
 func (c *TOMLConfig) FallbackGasPrice() sdk.Dec {
 	return sdkDecFromDecimal(c.Chain.FallbackGasPrice)
 }
 
+func (c *TOMLConfig) FeeBudget() sdk.Dec {
+	return sdkDecFromDecimal(c.Chain.FeeBudget)
+}
+
+func (c *TOMLConfig) FeeBudgetPeriod() time.Duration {
+	return c.Chain.FeeBudgetPeriod.Duration()
+}
+
+func (c *TOMLConfig) FeeDenoms() []string {
+	if len(c.Chain.FeeDenoms) == 0 {
+		return []string{c.GasToken()}
+	}
+	return c.Chain.FeeDenoms
+}
+
 func (c *TOMLConfig) GasToken() string {
 	return *c.Chain.GasToken
 }
@@ -349,10 +978,78 @@ func (c *TOMLConfig) GasLimitMultiplier() float64 {
 	return c.Chain.GasLimitMultiplier.InexactFloat64()
 }
 
+func (c *TOMLConfig) GasPriceSource() string {
+	return *c.Chain.GasPriceSource
+}
+
+func (c *TOMLConfig) GasPriceMultiplier() sdk.Dec {
+	return sdkDecFromDecimal(c.Chain.GasPriceMultiplier)
+}
+
+func (c *TOMLConfig) GasPriceMaxAge() time.Duration {
+	return c.Chain.GasPriceMaxAge.Duration()
+}
+
+func (c *TOMLConfig) GasPricePercentile() int64 {
+	return *c.Chain.GasPricePercentile
+}
+
+func (c *TOMLConfig) GasPricePercentileBlocks() int64 {
+	return *c.Chain.GasPricePercentileBlocks
+}
+
+func (c *TOMLConfig) GasPricePercentileTTL() time.Duration {
+	return c.Chain.GasPricePercentileTTL.Duration()
+}
+
+func (c *TOMLConfig) GasPricePercentileWeight() sdk.Dec {
+	return sdkDecFromDecimal(c.Chain.GasPricePercentileWeight)
+}
+
+func (c *TOMLConfig) GasPriceSourceWeight() sdk.Dec {
+	return sdkDecFromDecimal(c.Chain.GasPriceSourceWeight)
+}
+
+func (c *TOMLConfig) LightClientTrustedHeight() int64 {
+	return *c.Chain.LightClientTrustedHeight
+}
+
+func (c *TOMLConfig) LightClientTrustedHash() string {
+	return *c.Chain.LightClientTrustedHash
+}
+
+func (c *TOMLConfig) LightClientTrustPeriod() time.Duration {
+	return c.Chain.LightClientTrustPeriod.Duration()
+}
+
+func (c *TOMLConfig) LightClientVerifiedReads() bool {
+	return *c.Chain.LightClientVerifiedReads
+}
+
+func (c *TOMLConfig) LightClientPersistTrust() bool {
+	return *c.Chain.LightClientPersistTrust
+}
+
+func (c *TOMLConfig) LowBalanceThreshold() sdk.Dec {
+	return sdkDecFromDecimal(c.Chain.LowBalanceThreshold)
+}
+
+func (c *TOMLConfig) MaxGasPrice() sdk.Dec {
+	return sdkDecFromDecimal(c.Chain.MaxGasPrice)
+}
+
+func (c *TOMLConfig) MaxFeePerTx() sdk.Dec {
+	return sdkDecFromDecimal(c.Chain.MaxFeePerTx)
+}
+
 func (c *TOMLConfig) MaxMsgsPerBatch() int64 {
 	return *c.Chain.MaxMsgsPerBatch
 }
 
+func (c *TOMLConfig) MinGasPrice() sdk.Dec {
+	return sdkDecFromDecimal(c.Chain.MinGasPrice)
+}
+
 func (c *TOMLConfig) OCR2CachePollPeriod() time.Duration {
 	return c.Chain.OCR2CachePollPeriod.Duration()
 }
@@ -361,6 +1058,18 @@ func (c *TOMLConfig) OCR2CacheTTL() time.Duration {
 	return c.Chain.OCR2CacheTTL.Duration()
 }
 
+func (c *TOMLConfig) RequestRetryAttempts() int64 {
+	return *c.Chain.RequestRetryAttempts
+}
+
+func (c *TOMLConfig) RequestRetryMinBackoff() time.Duration {
+	return c.Chain.RequestRetryMinBackoff.Duration()
+}
+
+func (c *TOMLConfig) RequestRetryMaxBackoff() time.Duration {
+	return c.Chain.RequestRetryMaxBackoff.Duration()
+}
+
 func (c *TOMLConfig) TxMsgTimeout() time.Duration {
 	return c.Chain.TxMsgTimeout.Duration()
 }
@@ -370,6 +1079,10 @@ func sdkDecFromDecimal(d *decimal.Decimal) sdk.Dec {
 	return sdk.NewDecFromBigIntWithPrec(i.BigInt(), sdk.Precision)
 }
 
+func decimalFromSDKDec(d sdk.Dec) decimal.Decimal {
+	return decimal.NewFromBigInt(d.BigInt(), -sdk.Precision)
+}
+
 func (c *TOMLConfig) GetNode(name string) (db.Node, error) {
 	for _, n := range c.Nodes {
 		if *n.Name == name {
@@ -3,12 +3,16 @@ package config
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pelletier/go-toml/v2"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/client"
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/db"
 )
 
@@ -93,3 +97,145 @@ func TestCosmosConfig_GetNode(t *testing.T) {
 func ptr[T any](t T) *T {
 	return &t
 }
+
+func TestNode(t *testing.T) {
+	t.Run("role", func(t *testing.T) {
+		assert.Equal(t, NodeRolePrimary, (&Node{}).NodeRole())
+		assert.Equal(t, NodeRoleArchive, (&Node{Role: ptr(NodeRoleArchive)}).NodeRole())
+		assert.Equal(t, NodeRoleSendOnly, (&Node{Role: ptr(NodeRoleSendOnly)}).NodeRole())
+	})
+
+	t.Run("validate", func(t *testing.T) {
+		valid := &Node{
+			Name:              ptr("node"),
+			TendermintURL:     config.MustParseURL("http://node:26657"),
+			GRPCURL:           config.MustParseURL("https://node:9090"),
+			WebsocketURL:      config.MustParseURL("wss://node/websocket"),
+			RequestTimeout:    config.MustNewDuration(time.Second),
+			RateLimit:         ptr[int64](10),
+			BasicAuthUser:     ptr("user"),
+			BasicAuthPassword: ptr(Secret("password")),
+			Role:              ptr(NodeRoleArchive),
+			Priority:          ptr[int64](1),
+		}
+		assert.NoError(t, valid.ValidateConfig())
+
+		invalid := &Node{
+			Name:              ptr("node"),
+			TendermintURL:     config.MustParseURL("http://node:26657"),
+			GRPCURL:           config.MustParseURL("tcp://node:9090"),
+			RateLimit:         ptr[int64](-1),
+			BasicAuthPassword: ptr(Secret("password")),
+			Role:              ptr("backup"),
+			Priority:          ptr[int64](-1),
+		}
+		err := invalid.ValidateConfig()
+		for _, name := range []string{"GRPCURL", "RateLimit", "BasicAuthUser", "Role", "Priority"} {
+			assert.ErrorContains(t, err, name)
+		}
+	})
+
+	t.Run("send-only nodes", func(t *testing.T) {
+		c := &TOMLConfig{ChainID: ptr("chainID"), Nodes: Nodes{
+			{Name: ptr("node"), TendermintURL: config.MustParseURL("http://node:26657"), Role: ptr(NodeRoleSendOnly)},
+		}}
+		c.Chain.SetDefaults()
+		assert.ErrorContains(t, c.ValidateConfig(), "must have at least one node which is not send-only")
+	})
+
+	t.Run("adapters", func(t *testing.T) {
+		c := &TOMLConfig{ChainID: ptr("chainID"), Nodes: Nodes{
+			{Name: ptr("node"), TendermintURL: config.MustParseURL("http://node:26657")},
+		}}
+		c.Chain.SetDefaults()
+		c.Chain.ChainFamily, c.Chain.ContractKind = ptr(ChainFamilyInjective), ptr(ContractKindCosmWasm)
+		require.NoError(t, c.ValidateConfig())
+
+		c.Chain.ChainFamily, c.Chain.ContractKind = ptr("evm"), ptr("solidity")
+		err := c.ValidateConfig()
+		assert.ErrorContains(t, err, "ChainFamily: invalid value (evm)")
+		assert.ErrorContains(t, err, "ContractKind: invalid value (solidity)")
+	})
+
+	t.Run("fee caps", func(t *testing.T) {
+		c := &TOMLConfig{ChainID: ptr("chainID"), Nodes: Nodes{
+			{Name: ptr("node"), TendermintURL: config.MustParseURL("http://node:26657")},
+		}}
+		c.Chain.SetDefaults()
+		c.Chain.FeeDenoms = []string{"ucosm", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"}
+		require.NoError(t, c.ValidateConfig())
+
+		maxFee := decimal.NewFromInt(1000)
+		c.Chain.MaxFeePerTx = &maxFee
+		err := c.ValidateConfig()
+		assert.ErrorContains(t, err, "FeeDenoms")
+		assert.ErrorContains(t, err, "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2")
+
+		c.Chain.FeeDenoms = []string{"ucosm"}
+		assert.NoError(t, c.ValidateConfig())
+	})
+
+	t.Run("light client", func(t *testing.T) {
+		c := &TOMLConfig{ChainID: ptr("chainID"), Nodes: Nodes{
+			{Name: ptr("node"), TendermintURL: config.MustParseURL("http://node:26657")},
+		}}
+		c.Chain.SetDefaults()
+		c.Chain.LightClientVerifiedReads = ptr(true)
+		c.Chain.LightClientPersistTrust = ptr(true)
+		assert.ErrorContains(t, c.ValidateConfig(), "LightClientTrustedHeight: missing: required with LightClientVerifiedReads")
+		assert.ErrorContains(t, c.ValidateConfig(), "LightClientTrustedHeight: missing: required with LightClientPersistTrust")
+
+		c.Chain.LightClientTrustedHeight = ptr(int64(100))
+		c.Chain.LightClientTrustedHash = ptr("0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20")
+		assert.NoError(t, c.ValidateConfig())
+	})
+
+	t.Run("request retries", func(t *testing.T) {
+		c := &TOMLConfig{ChainID: ptr("chainID"), Nodes: Nodes{
+			{Name: ptr("node"), TendermintURL: config.MustParseURL("http://node:26657")},
+		}}
+		c.Chain.SetDefaults()
+		assert.Equal(t, client.DefaultRetryConfig.MaxBackoff, c.RequestRetryMaxBackoff())
+		c.Chain.RequestRetryAttempts = ptr[int64](0)
+		c.Chain.RequestRetryMinBackoff = config.MustNewDuration(time.Second)
+		c.Chain.RequestRetryMaxBackoff = config.MustNewDuration(time.Millisecond)
+		err := c.ValidateConfig()
+		assert.ErrorContains(t, err, "RequestRetryAttempts: invalid value (0): must be positive")
+		assert.ErrorContains(t, err, "RequestRetryMaxBackoff: invalid value (1ms): must not be less than RequestRetryMinBackoff")
+
+		c.Chain.RequestRetryAttempts = ptr[int64](1)
+		c.Chain.RequestRetryMaxBackoff = config.MustNewDuration(time.Second)
+		assert.NoError(t, c.ValidateConfig())
+	})
+
+	t.Run("secrets", func(t *testing.T) {
+		c := &TOMLConfig{ChainID: ptr("chainID"), Nodes: Nodes{{
+			Name:              ptr("node"),
+			TendermintURL:     config.MustParseURL("http://node:26657"),
+			BasicAuthUser:     ptr("user"),
+			BasicAuthPassword: ptr(Secret("password")),
+			AuthHeaders:       map[string]Secret{"X-Api-Key": "key"},
+		}}}
+		s, err := c.TOMLString()
+		require.NoError(t, err)
+		assert.NotContains(t, s, "password")
+		assert.NotContains(t, s, `"key"`)
+		assert.Contains(t, s, "user")
+
+		var decoded TOMLConfig
+		require.NoError(t, toml.Unmarshal([]byte(`
+ChainID = 'chainID'
+[[Nodes]]
+Name = 'node'
+TendermintURL = 'http://node:26657'
+BasicAuthUser = 'user'
+BasicAuthPassword = 'password'
+AuthHeaders = { X-Api-Key = 'key' }
+`), &decoded))
+		assert.Equal(t, client.Auth{
+			BasicAuthUser:     "user",
+			BasicAuthPassword: "password",
+			Headers:           map[string]string{"X-Api-Key": "key"},
+		}, decoded.Nodes[0].Auth())
+	})
+}
@@ -0,0 +1,331 @@
+package config
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Reloadable is a Config whose TOMLConfig can be replaced at runtime. Its values are read from the current
+// TOMLConfig on each call, so services holding it observe updates without being rebuilt.
+type Reloadable struct {
+	cur atomic.Pointer[TOMLConfig]
+}
+
+var _ Config = (*Reloadable)(nil)
+
+// NewReloadable returns a Reloadable initialized with cfg.
+func NewReloadable(cfg *TOMLConfig) *Reloadable {
+	r := &Reloadable{}
+	r.cur.Store(cfg)
+	return r
+}
+
+// Current returns the current TOMLConfig, which must not be modified.
+func (r *Reloadable) Current() *TOMLConfig {
+	return r.cur.Load()
+}
+
+// Store replaces the current TOMLConfig. Updates should be checked with ValidateUpdate first.
+func (r *Reloadable) Store(cfg *TOMLConfig) {
+	r.cur.Store(cfg)
+}
+
+func (r *Reloadable) BalancePollPeriod() time.Duration {
+	return r.Current().BalancePollPeriod()
+}
+
+func (r *Reloadable) Bech32Prefix() string {
+	return r.Current().Bech32Prefix()
+}
+
+func (r *Reloadable) BlockRate() time.Duration {
+	return r.Current().BlockRate()
+}
+
+func (r *Reloadable) BlocksUntilTxTimeout() int64 {
+	return r.Current().BlocksUntilTxTimeout()
+}
+
+func (r *Reloadable) BroadcastFanOut() bool {
+	return r.Current().BroadcastFanOut()
+}
+
+func (r *Reloadable) ChainFamily() string {
+	return r.Current().ChainFamily()
+}
+
+func (r *Reloadable) ConfirmPollPeriod() time.Duration {
+	return r.Current().ConfirmPollPeriod()
+}
+
+func (r *Reloadable) ContractKind() string {
+	return r.Current().ContractKind()
+}
+
+func (r *Reloadable) FallbackGasPrice() sdk.Dec {
+	return r.Current().FallbackGasPrice()
+}
+
+func (r *Reloadable) FeeBudget() sdk.Dec {
+	return r.Current().FeeBudget()
+}
+
+func (r *Reloadable) FeeBudgetPeriod() time.Duration {
+	return r.Current().FeeBudgetPeriod()
+}
+
+func (r *Reloadable) FeeDenoms() []string {
+	return r.Current().FeeDenoms()
+}
+
+func (r *Reloadable) GasToken() string {
+	return r.Current().GasToken()
+}
+
+func (r *Reloadable) GasLimitMultiplier() float64 {
+	return r.Current().GasLimitMultiplier()
+}
+
+func (r *Reloadable) GasPriceSource() string {
+	return r.Current().GasPriceSource()
+}
+
+func (r *Reloadable) GasPriceMaxAge() time.Duration {
+	return r.Current().GasPriceMaxAge()
+}
+
+func (r *Reloadable) GasPriceMultiplier() sdk.Dec {
+	return r.Current().GasPriceMultiplier()
+}
+
+func (r *Reloadable) GasPricePercentile() int64 {
+	return r.Current().GasPricePercentile()
+}
+
+func (r *Reloadable) GasPricePercentileBlocks() int64 {
+	return r.Current().GasPricePercentileBlocks()
+}
+
+func (r *Reloadable) GasPricePercentileTTL() time.Duration {
+	return r.Current().GasPricePercentileTTL()
+}
+
+func (r *Reloadable) GasPricePercentileWeight() sdk.Dec {
+	return r.Current().GasPricePercentileWeight()
+}
+
+func (r *Reloadable) GasPriceSourceWeight() sdk.Dec {
+	return r.Current().GasPriceSourceWeight()
+}
+
+func (r *Reloadable) LightClientTrustedHeight() int64 {
+	return r.Current().LightClientTrustedHeight()
+}
+
+func (r *Reloadable) LightClientTrustedHash() string {
+	return r.Current().LightClientTrustedHash()
+}
+
+func (r *Reloadable) LightClientTrustPeriod() time.Duration {
+	return r.Current().LightClientTrustPeriod()
+}
+
+func (r *Reloadable) LightClientVerifiedReads() bool {
+	return r.Current().LightClientVerifiedReads()
+}
+
+func (r *Reloadable) LightClientPersistTrust() bool {
+	return r.Current().LightClientPersistTrust()
+}
+
+func (r *Reloadable) LowBalanceThreshold() sdk.Dec {
+	return r.Current().LowBalanceThreshold()
+}
+
+func (r *Reloadable) MaxFeePerTx() sdk.Dec {
+	return r.Current().MaxFeePerTx()
+}
+
+func (r *Reloadable) MaxGasPrice() sdk.Dec {
+	return r.Current().MaxGasPrice()
+}
+
+func (r *Reloadable) MaxMsgsPerBatch() int64 {
+	return r.Current().MaxMsgsPerBatch()
+}
+
+func (r *Reloadable) MinGasPrice() sdk.Dec {
+	return r.Current().MinGasPrice()
+}
+
+func (r *Reloadable) OCR2CachePollPeriod() time.Duration {
+	return r.Current().OCR2CachePollPeriod()
+}
+
+func (r *Reloadable) OCR2CacheTTL() time.Duration {
+	return r.Current().OCR2CacheTTL()
+}
+
+func (r *Reloadable) RequestRetryAttempts() int64 {
+	return r.Current().RequestRetryAttempts()
+}
+
+func (r *Reloadable) RequestRetryMinBackoff() time.Duration {
+	return r.Current().RequestRetryMinBackoff()
+}
+
+func (r *Reloadable) RequestRetryMaxBackoff() time.Duration {
+	return r.Current().RequestRetryMaxBackoff()
+}
+
+func (r *Reloadable) TxMsgTimeout() time.Duration {
+	return r.Current().TxMsgTimeout()
+}
+
+// Change is a setting changed by a config update. From is empty for added nodes, and To for removed ones.
+type Change struct {
+	Name     string
+	From, To string
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %q -> %q", c.Name, c.From, c.To)
+}
+
+// restartSettings are only read when the chain is built, so they cannot be changed by an update.
+var restartSettings = []string{
+	"ChainID",
+	"Enabled",
+	"Bech32Prefix",
+	"BlockRate", // bounds the age of heads of the head tracker
+	"ChainFamily",
+	"ContractKind",
+	"GasToken",
+	"LightClientTrustedHeight",
+	"LightClientTrustedHash",
+	"LightClientTrustPeriod",
+	"LightClientVerifiedReads", // only read when the OCR readers are built
+	"LightClientPersistTrust",
+}
+
+// ErrRestartRequired is returned by ValidateUpdate for updates which only apply to a new chain.
+var ErrRestartRequired = errors.New("restart required")
+
+// ValidateUpdate returns an error if update is invalid, or changes settings which require a restart.
+// Nodes require a restart too when the light client is enabled, since it is built with them.
+func (c *TOMLConfig) ValidateUpdate(update *TOMLConfig) error {
+	if err := update.ValidateConfig(); err != nil {
+		return err
+	}
+	for _, change := range c.Diff(update) {
+		if slices.Contains(restartSettings, change.Name) {
+			return fmt.Errorf("%w to change %s", ErrRestartRequired, change)
+		}
+		if c.LightClientTrustedHeight() > 0 && strings.HasPrefix(change.Name, "Nodes.") {
+			return fmt.Errorf("%w to change %s with the light client enabled", ErrRestartRequired, change)
+		}
+	}
+	return nil
+}
+
+// Diff returns the settings changed by update, by order of declaration, followed by the nodes by name.
+func (c *TOMLConfig) Diff(update *TOMLConfig) (changes []Change) {
+	diff := func(name string, from, to reflect.Value) {
+		if f, t := formatValue(from), formatValue(to); f != t {
+			changes = append(changes, Change{Name: name, From: f, To: t})
+		}
+	}
+	diff("ChainID", reflect.ValueOf(c.ChainID), reflect.ValueOf(update.ChainID))
+	diff("Enabled", reflect.ValueOf(c.IsEnabled()), reflect.ValueOf(update.IsEnabled()))
+	from, to := reflect.ValueOf(c.Chain), reflect.ValueOf(update.Chain)
+	for i := 0; i < from.NumField(); i++ {
+		diff(from.Type().Field(i).Name, from.Field(i), to.Field(i))
+	}
+
+	nodes := func(ns Nodes) map[string]*Node {
+		m := make(map[string]*Node, len(ns))
+		for _, n := range ns {
+			if n.Name != nil {
+				m[*n.Name] = n
+			}
+		}
+		return m
+	}
+	fromNodes, toNodes := nodes(c.Nodes), nodes(update.Nodes)
+	var names []string
+	for name := range fromNodes {
+		names = append(names, name)
+	}
+	for name := range toNodes {
+		if _, ok := fromNodes[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		// compared by value, since secrets are redacted when formatted
+		if f, t := fromNodes[name], toNodes[name]; !reflect.DeepEqual(f, t) {
+			changes = append(changes, Change{Name: "Nodes." + name, From: formatNode(f), To: formatNode(t)})
+		}
+	}
+	return changes
+}
+
+func formatValue(v reflect.Value) string {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return ""
+		}
+		if m, ok := v.Interface().(encoding.TextMarshaler); ok {
+			if b, err := m.MarshalText(); err == nil {
+				return string(b)
+			}
+		}
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Map {
+		keys := make([]string, 0, v.Len())
+		for _, k := range v.MapKeys() {
+			keys = append(keys, k.String())
+		}
+		slices.Sort(keys)
+		for i, k := range keys {
+			keys[i] = k + ":" + formatValue(v.MapIndex(reflect.ValueOf(k)))
+		}
+		return "{" + strings.Join(keys, ",") + "}"
+	}
+	switch i := v.Interface().(type) {
+	case fmt.Stringer:
+		return i.String()
+	case encoding.TextMarshaler:
+		if b, err := i.MarshalText(); err == nil {
+			return string(b)
+		}
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+// formatNode formats the settings of n, other than its name, as space separated Name=value pairs.
+func formatNode(n *Node) string {
+	if n == nil {
+		return ""
+	}
+	var fields []string
+	v := reflect.ValueOf(n).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		name, f := v.Type().Field(i).Name, v.Field(i)
+		if name == "Name" || f.IsNil() {
+			continue
+		}
+		fields = append(fields, name+"="+formatValue(f))
+	}
+	return strings.Join(fields, " ")
+}
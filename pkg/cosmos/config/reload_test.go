@@ -0,0 +1,96 @@
+package config
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/config"
+)
+
+func newReloadTestConfig(t *testing.T, nodes ...string) *TOMLConfig {
+	chainID := "chain"
+	cfg := &TOMLConfig{ChainID: &chainID}
+	cfg.Chain.SetDefaults()
+	for _, name := range nodes {
+		u, err := url.Parse("http://" + name + ":26657")
+		require.NoError(t, err)
+		name := name
+		cfg.Nodes = append(cfg.Nodes, &Node{Name: &name, TendermintURL: (*config.URL)(u)})
+	}
+	return cfg
+}
+
+func TestTOMLConfig_Diff(t *testing.T) {
+	cfg := newReloadTestConfig(t, "a", "b")
+
+	update := newReloadTestConfig(t, "b", "c")
+	fallback := decimal.RequireFromString("0.05")
+	update.Chain.FallbackGasPrice = &fallback
+	maxMsgs := int64(7)
+	update.Chain.MaxMsgsPerBatch = &maxMsgs
+	role := NodeRoleArchive
+	update.Nodes[0].Role = &role
+
+	assert.Empty(t, cfg.Diff(newReloadTestConfig(t, "a", "b")))
+	assert.Equal(t, []Change{
+		{Name: "FallbackGasPrice", From: "0.015", To: "0.05"},
+		{Name: "MaxMsgsPerBatch", From: "100", To: "7"},
+		{Name: "Nodes.a", From: "TendermintURL=http://a:26657"},
+		{Name: "Nodes.b", From: "TendermintURL=http://b:26657", To: "TendermintURL=http://b:26657 Role=archive"},
+		{Name: "Nodes.c", To: "TendermintURL=http://c:26657"},
+	}, cfg.Diff(update))
+	assert.Equal(t, `MaxMsgsPerBatch: "100" -> "7"`, Change{Name: "MaxMsgsPerBatch", From: "100", To: "7"}.String())
+}
+
+func TestTOMLConfig_ValidateUpdate(t *testing.T) {
+	cfg := newReloadTestConfig(t, "a")
+
+	t.Run("nodes and batching", func(t *testing.T) {
+		update := newReloadTestConfig(t, "a", "b")
+		maxMsgs := int64(7)
+		update.Chain.MaxMsgsPerBatch = &maxMsgs
+		require.NoError(t, cfg.ValidateUpdate(update))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		update := newReloadTestConfig(t)
+		assert.ErrorContains(t, cfg.ValidateUpdate(update), "Nodes")
+	})
+
+	t.Run("restart required", func(t *testing.T) {
+		update := newReloadTestConfig(t, "a")
+		gasToken := "uatom"
+		update.Chain.GasToken = &gasToken
+		err := cfg.ValidateUpdate(update)
+		assert.ErrorIs(t, err, ErrRestartRequired)
+		assert.ErrorContains(t, err, "GasToken")
+	})
+
+	t.Run("light client nodes", func(t *testing.T) {
+		light := newReloadTestConfig(t, "a")
+		height := int64(10)
+		hash := "0000000000000000000000000000000000000000000000000000000000000001"
+		light.Chain.LightClientTrustedHeight, light.Chain.LightClientTrustedHash = &height, &hash
+
+		update := newReloadTestConfig(t, "a", "b")
+		update.Chain.LightClientTrustedHeight, update.Chain.LightClientTrustedHash = &height, &hash
+		assert.ErrorIs(t, light.ValidateUpdate(update), ErrRestartRequired)
+	})
+}
+
+func TestReloadable(t *testing.T) {
+	cfg := newReloadTestConfig(t, "a")
+	r := NewReloadable(cfg)
+	assert.Equal(t, cfg.MaxMsgsPerBatch(), r.MaxMsgsPerBatch())
+
+	update := newReloadTestConfig(t, "a")
+	maxMsgs := int64(7)
+	update.Chain.MaxMsgsPerBatch = &maxMsgs
+	r.Store(update)
+	assert.Equal(t, int64(7), r.MaxMsgsPerBatch())
+	assert.Same(t, update, r.Current())
+}
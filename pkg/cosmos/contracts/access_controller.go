@@ -0,0 +1,34 @@
+package contracts
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccessController is a client of the access-controller contract.
+type AccessController struct {
+	Contract
+}
+
+// NewAccessController returns a client of the access-controller contract at address.
+func NewAccessController(address sdk.AccAddress, querier Querier, opts ...Option) *AccessController {
+	return &AccessController{newContract(address, querier, opts)}
+}
+
+func (c *AccessController) HasAccess(address string) (bool, error) {
+	return query[bool](c.Contract, "has_access", struct {
+		Address string `json:"address"`
+	}{address})
+}
+
+func (c *AccessController) AddAccessMsg(sender sdk.AccAddress, address string) (*wasmtypes.MsgExecuteContract, error) {
+	return c.execute(sender, "add_access", struct {
+		Address string `json:"address"`
+	}{address})
+}
+
+func (c *AccessController) RemoveAccessMsg(sender sdk.AccAddress, address string) (*wasmtypes.MsgExecuteContract, error) {
+	return c.execute(sender, "remove_access", struct {
+		Address string `json:"address"`
+	}{address})
+}
@@ -0,0 +1,193 @@
+// Package contracts provides typed clients for the CosmWasm contracts in the contracts directory of this repository.
+// Clients build query and execute msgs, decode query responses, and read raw storage for state which has no query.
+package contracts
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
+)
+
+// Querier queries the state of contracts. It is implemented by client.Reader.
+type Querier interface {
+	ContractState(contractAddress sdk.AccAddress, queryMsg []byte) ([]byte, error)
+	// RawContractState returns the value stored under key, or nil if there is none.
+	RawContractState(contractAddress sdk.AccAddress, key []byte) ([]byte, error)
+}
+
+// ErrNotFound is returned when reading a key which is absent from contract storage.
+var ErrNotFound = errors.New("not found in contract storage")
+
+// Msg returns the JSON encoding of a variant of the ExecuteMsg or QueryMsg enum of a contract,
+// which serde tags externally: {"variant":fields}. A nil fields encodes a unit variant: "variant".
+func Msg(variant string, fields any) ([]byte, error) {
+	if fields == nil {
+		return json.Marshal(variant)
+	}
+	return json.Marshal(map[string]any{variant: fields})
+}
+
+// ItemKey returns the storage key of a cw-storage-plus Item.
+func ItemKey(namespace string) []byte {
+	return []byte(namespace)
+}
+
+// MapKey returns the storage key of the entry for key in a cw-storage-plus Map,
+// which is the namespace prefixed by its big endian uint16 length, followed by key.
+func MapKey(namespace string, key []byte) []byte {
+	out := make([]byte, 2, 2+len(namespace)+len(key))
+	binary.BigEndian.PutUint16(out, uint16(len(namespace)))
+	out = append(out, namespace...)
+	return append(out, key...)
+}
+
+// Uint32Key returns the Map key encoding of a u32.
+func Uint32Key(i uint32) []byte {
+	return binary.BigEndian.AppendUint32(nil, i)
+}
+
+// Int is an integer encoded as a decimal string, like the Uint128 and the bignum encoded i128 values of the contracts.
+type Int struct {
+	*big.Int
+}
+
+// NewInt returns an Int with value i.
+func NewInt(i int64) Int {
+	return Int{big.NewInt(i)}
+}
+
+func (i Int) MarshalJSON() ([]byte, error) {
+	if i.Int == nil {
+		return json.Marshal("0")
+	}
+	return json.Marshal(i.String())
+}
+
+func (i *Int) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return fmt.Errorf("invalid integer %q", s)
+	}
+	i.Int = v
+	return nil
+}
+
+// uint128 returns the encoding of a Uint128 with value i.
+func uint128(i uint64) string {
+	return strconv.FormatUint(i, 10)
+}
+
+// Ownership is the state of the owned crate, which every contract uses to manage its owner.
+type Ownership struct {
+	Owner         string  `json:"owner"`
+	ProposedOwner *string `json:"proposed_owner"`
+}
+
+// Contract is the part of the client common to all contracts, which are owned.
+type Contract struct {
+	address sdk.AccAddress
+	querier Querier
+	prefix  params.Bech32Prefix
+}
+
+// Option configures a contract client.
+type Option func(*Contract)
+
+// WithBech32Prefix sets the prefix of the addresses in the msgs built by the client.
+// Defaults to the prefix of the global sdk config.
+func WithBech32Prefix(prefix string) Option {
+	return func(c *Contract) {
+		c.prefix = params.Bech32Prefix(prefix)
+	}
+}
+
+func newContract(address sdk.AccAddress, querier Querier, opts []Option) Contract {
+	c := Contract{address: address, querier: querier}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// Address returns the address of the contract.
+func (c Contract) Address() sdk.AccAddress { return c.address }
+
+// Owner queries the owner of the contract.
+func (c Contract) Owner() (string, error) {
+	return query[string](c, "owner", struct{}{})
+}
+
+// Ownership reads the ownership state of the contract, which includes a pending transfer.
+func (c Contract) Ownership() (Ownership, error) {
+	return raw[Ownership](c, ItemKey("owner"))
+}
+
+// TransferOwnershipMsg returns a msg from sender proposing to transfer ownership of the contract to.
+func (c Contract) TransferOwnershipMsg(sender sdk.AccAddress, to string) (*wasmtypes.MsgExecuteContract, error) {
+	return c.execute(sender, "transfer_ownership", struct {
+		To string `json:"to"`
+	}{to})
+}
+
+// AcceptOwnershipMsg returns a msg from sender accepting a proposed ownership transfer.
+func (c Contract) AcceptOwnershipMsg(sender sdk.AccAddress) (*wasmtypes.MsgExecuteContract, error) {
+	return c.execute(sender, "accept_ownership", nil)
+}
+
+// execute returns a msg from sender executing variant of the contract's ExecuteMsg, without funds.
+func (c Contract) execute(sender sdk.AccAddress, variant string, fields any) (*wasmtypes.MsgExecuteContract, error) {
+	msg, err := Msg(variant, fields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s msg: %w", variant, err)
+	}
+	return &wasmtypes.MsgExecuteContract{
+		Sender:   c.prefix.Address(sender),
+		Contract: c.prefix.Address(c.address),
+		Msg:      msg,
+		Funds:    sdk.Coins{},
+	}, nil
+}
+
+// query queries variant of the contract's QueryMsg, and decodes the response as a T.
+func query[T any](c Contract, variant string, fields any) (resp T, err error) {
+	msg, err := Msg(variant, fields)
+	if err != nil {
+		return resp, fmt.Errorf("failed to encode %s query: %w", variant, err)
+	}
+	b, err := c.querier.ContractState(c.address, msg)
+	if err != nil {
+		return resp, fmt.Errorf("failed to query %s: %w", variant, err)
+	}
+	if err = json.Unmarshal(b, &resp); err != nil {
+		return resp, fmt.Errorf("failed to decode %s response %q: %w", variant, string(b), err)
+	}
+	return resp, nil
+}
+
+// raw reads the value stored under key by the contract, and decodes it as a T.
+// ErrNotFound is returned if there is none.
+func raw[T any](c Contract, key []byte) (value T, err error) {
+	b, err := c.querier.RawContractState(c.address, key)
+	if err != nil {
+		return value, fmt.Errorf("failed to read key %q: %w", key, err)
+	}
+	if len(b) == 0 {
+		return value, fmt.Errorf("key %q: %w", key, ErrNotFound)
+	}
+	if err = json.Unmarshal(b, &value); err != nil {
+		return value, fmt.Errorf("failed to decode value of key %q: %w", key, err)
+	}
+	return value, nil
+}
@@ -0,0 +1,113 @@
+package contracts
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeQuerier serves fixed responses by query msg and storage key.
+type fakeQuerier struct {
+	queries map[string]string
+	storage map[string]string
+}
+
+func (q *fakeQuerier) ContractState(_ sdk.AccAddress, queryMsg []byte) ([]byte, error) {
+	return []byte(q.queries[string(queryMsg)]), nil
+}
+
+func (q *fakeQuerier) RawContractState(_ sdk.AccAddress, key []byte) ([]byte, error) {
+	if v, ok := q.storage[string(key)]; ok {
+		return []byte(v), nil
+	}
+	return nil, nil
+}
+
+func TestMsg(t *testing.T) {
+	for _, tt := range []struct {
+		variant string
+		fields  any
+		exp     string
+	}{
+		{"accept_ownership", nil, `"accept_ownership"`},
+		{"latest_config_details", struct{}{}, `{"latest_config_details":{}}`},
+		{"round_data", struct {
+			RoundID uint32 `json:"round_id"`
+		}{7}, `{"round_data":{"round_id":7}}`},
+	} {
+		t.Run(tt.variant, func(t *testing.T) {
+			msg, err := Msg(tt.variant, tt.fields)
+			require.NoError(t, err)
+			assert.Equal(t, tt.exp, string(msg))
+		})
+	}
+}
+
+func TestMapKey(t *testing.T) {
+	assert.Equal(t, "\x00\x0dtransmissions\x00\x00\x01\x02", string(MapKey("transmissions", Uint32Key(258))))
+	assert.Equal(t, "\x00\x06payeeswasm1abc", string(MapKey("payees", []byte("wasm1abc"))))
+}
+
+func TestOCR2(t *testing.T) {
+	q := &fakeQuerier{
+		queries: map[string]string{
+			`{"latest_transmission_details":{}}`: `{"latest_config_digest":[0,2,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1],"epoch":3,"round":4,"latest_answer":"-1234567890123456789012","latest_timestamp":1645456354}`,
+			`{"proposal":{"id":"5"}}`:            `{"owner":"wasm1owner","finalized":true,"oracles":[["AQI=","wasm1transmitter","wasm1payee"]],"f":1,"offchain_config_version":2,"offchain_config":"AQ=="}`,
+			`{"owner":{}}`:                       `"wasm1owner"`,
+		},
+		storage: map[string]string{
+			"\x00\x0ctransmitters" + "wasm1transmitter": `{"payment":"100","from_round_id":6}`,
+			"owner": `{"owner":"wasm1owner","proposed_owner":"wasm1next"}`,
+		},
+	}
+	contract := sdk.AccAddress("contract____________")
+	ocr2 := NewOCR2(contract, q)
+
+	details, err := ocr2.LatestTransmissionDetails()
+	require.NoError(t, err)
+	assert.Equal(t, "0002000000000000000000000000000000000000000000000000000000000001", details.LatestConfigDigest.Hex())
+	assert.Equal(t, uint32(3), details.Epoch)
+	assert.Equal(t, "-1234567890123456789012", details.LatestAnswer.String())
+
+	proposal, err := ocr2.Proposal(5)
+	require.NoError(t, err)
+	assert.Equal(t, []Oracle{{Signer: []byte{1, 2}, Transmitter: "wasm1transmitter", Payee: "wasm1payee"}}, proposal.Oracles)
+
+	owner, err := ocr2.Owner()
+	require.NoError(t, err)
+	assert.Equal(t, "wasm1owner", owner)
+
+	ownership, err := ocr2.Ownership()
+	require.NoError(t, err)
+	require.NotNil(t, ownership.ProposedOwner)
+	assert.Equal(t, "wasm1next", *ownership.ProposedOwner)
+
+	transmitter, err := ocr2.Transmitter("wasm1transmitter")
+	require.NoError(t, err)
+	assert.Equal(t, Transmitter{Payment: NewInt(100), FromRoundID: 6}, transmitter)
+
+	_, err = ocr2.Transmitter("wasm1other")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	// the contract returns an error for unknown msgs
+	_, err = ocr2.Billing()
+	require.ErrorContains(t, err, "failed to decode billing response")
+
+	sender := sdk.AccAddress("sender______________")
+	msg, err := ocr2.TransmitMsg(sender, []byte{1}, []byte{2}, [][]byte{{3}})
+	require.NoError(t, err)
+	assert.Equal(t, contract.String(), msg.Contract)
+	assert.Equal(t, sender.String(), msg.Sender)
+	assert.JSONEq(t, `{"transmit":{"report_context":"AQ==","report":"Ag==","signatures":["Aw=="]}}`, string(msg.Msg))
+
+	msg, err = ocr2.WithdrawFundsMsg(sender, "wasm1recipient", big.NewInt(42))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"withdraw_funds":{"recipient":"wasm1recipient","amount":"42"}}`, string(msg.Msg))
+
+	msg, err = ocr2.BeginProposalMsg(sender)
+	require.NoError(t, err)
+	assert.Equal(t, `"begin_proposal"`, string(msg.Msg))
+}
@@ -0,0 +1,68 @@
+package contracts
+
+import (
+	"math/big"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DeviationFlaggingValidator is a client of the deviation-flagging-validator contract.
+type DeviationFlaggingValidator struct {
+	Contract
+}
+
+// NewDeviationFlaggingValidator returns a client of the deviation-flagging-validator contract at address.
+func NewDeviationFlaggingValidator(address sdk.AccAddress, querier Querier, opts ...Option) *DeviationFlaggingValidator {
+	return &DeviationFlaggingValidator{newContract(address, querier, opts)}
+}
+
+// DeviationFlaggingValidatorConfig is the config stored by the deviation-flagging-validator contract.
+type DeviationFlaggingValidatorConfig struct {
+	FlaggingThreshold uint32 `json:"flagging_threshold"`
+	Flags             string `json:"flags"`
+}
+
+// IsValid queries whether the change from previousAnswer to answer is within the flagging threshold.
+func (c *DeviationFlaggingValidator) IsValid(previousAnswer, answer *big.Int) (bool, error) {
+	return query[bool](c.Contract, "is_valid", struct {
+		PreviousAnswer Int `json:"previous_answer"`
+		Answer         Int `json:"answer"`
+	}{Int{previousAnswer}, Int{answer}})
+}
+
+// FlaggingThreshold queries the threshold, where 100,000 tolerates a 100% change.
+func (c *DeviationFlaggingValidator) FlaggingThreshold() (uint32, error) {
+	resp, err := query[struct {
+		Threshold uint32 `json:"threshold"`
+	}](c.Contract, "flagging_threshold", struct{}{})
+	return resp.Threshold, err
+}
+
+// Config reads the config stored by the contract, which includes the address of the flags contract.
+func (c *DeviationFlaggingValidator) Config() (DeviationFlaggingValidatorConfig, error) {
+	return raw[DeviationFlaggingValidatorConfig](c.Contract, ItemKey("config"))
+}
+
+func (c *DeviationFlaggingValidator) SetFlaggingThresholdMsg(sender sdk.AccAddress, threshold uint32) (*wasmtypes.MsgExecuteContract, error) {
+	return c.execute(sender, "set_flagging_threshold", struct {
+		Threshold uint32 `json:"threshold"`
+	}{threshold})
+}
+
+func (c *DeviationFlaggingValidator) SetFlagsAddressMsg(sender sdk.AccAddress, flags string) (*wasmtypes.MsgExecuteContract, error) {
+	return c.execute(sender, "set_flags_address", struct {
+		Flags string `json:"flags"`
+	}{flags})
+}
+
+// ValidateMsg returns a msg raising the flag of sender if the change from previousAnswer to answer exceeds the threshold.
+// It is usually sent by the ocr2 contract, as its validator.
+func (c *DeviationFlaggingValidator) ValidateMsg(sender sdk.AccAddress, previousRoundID uint32, previousAnswer *big.Int, roundID uint32, answer *big.Int) (*wasmtypes.MsgExecuteContract, error) {
+	return c.execute(sender, "validate", struct {
+		PreviousRoundID uint32 `json:"previous_round_id"`
+		PreviousAnswer  Int    `json:"previous_answer"`
+		RoundID         uint32 `json:"round_id"`
+		Answer          Int    `json:"answer"`
+	}{previousRoundID, Int{previousAnswer}, roundID, Int{answer}})
+}
@@ -0,0 +1,69 @@
+package contracts
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Flags is a client of the flags contract.
+type Flags struct {
+	Contract
+}
+
+// NewFlags returns a client of the flags contract at address.
+func NewFlags(address sdk.AccAddress, querier Querier, opts ...Option) *Flags {
+	return &Flags{newContract(address, querier, opts)}
+}
+
+// FlagsConfig is the config stored by the flags contract.
+type FlagsConfig struct {
+	RaisingAccessController  string `json:"raising_access_controller"`
+	LoweringAccessController string `json:"lowering_access_controller"`
+}
+
+// Flag queries whether the flag of subject is raised.
+func (c *Flags) Flag(subject string) (bool, error) {
+	return query[bool](c.Contract, "flag", struct {
+		Subject string `json:"subject"`
+	}{subject})
+}
+
+// Flags queries whether the flags of subjects are raised, in order.
+func (c *Flags) Flags(subjects []string) ([]bool, error) {
+	return query[[]bool](c.Contract, "flags", struct {
+		Subjects []string `json:"subjects"`
+	}{subjects})
+}
+
+func (c *Flags) RaisingAccessController() (string, error) {
+	return query[string](c.Contract, "raising_access_controller", struct{}{})
+}
+
+// Config reads the config stored by the contract, which includes the lowering access controller.
+func (c *Flags) Config() (FlagsConfig, error) {
+	return raw[FlagsConfig](c.Contract, ItemKey("config"))
+}
+
+func (c *Flags) RaiseFlagMsg(sender sdk.AccAddress, subject string) (*wasmtypes.MsgExecuteContract, error) {
+	return c.execute(sender, "raise_flag", struct {
+		Subject string `json:"subject"`
+	}{subject})
+}
+
+func (c *Flags) RaiseFlagsMsg(sender sdk.AccAddress, subjects []string) (*wasmtypes.MsgExecuteContract, error) {
+	return c.execute(sender, "raise_flags", struct {
+		Subjects []string `json:"subjects"`
+	}{subjects})
+}
+
+func (c *Flags) LowerFlagsMsg(sender sdk.AccAddress, subjects []string) (*wasmtypes.MsgExecuteContract, error) {
+	return c.execute(sender, "lower_flags", struct {
+		Subjects []string `json:"subjects"`
+	}{subjects})
+}
+
+func (c *Flags) SetRaisingAccessControllerMsg(sender sdk.AccAddress, accessController string) (*wasmtypes.MsgExecuteContract, error) {
+	return c.execute(sender, "set_raising_access_controller", struct {
+		RACAddress string `json:"rac_address"`
+	}{accessController})
+}
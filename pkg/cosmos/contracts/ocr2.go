@@ -0,0 +1,369 @@
+package contracts
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/smartcontractkit/libocr/offchainreporting2/types"
+)
+
+// OCR2 is a client of the ocr2 contract.
+type OCR2 struct {
+	Contract
+}
+
+// NewOCR2 returns a client of the ocr2 contract at address.
+func NewOCR2(address sdk.AccAddress, querier Querier, opts ...Option) *OCR2 {
+	return &OCR2{newContract(address, querier, opts)}
+}
+
+type LatestConfigDetails struct {
+	ConfigCount  uint32             `json:"config_count"`
+	BlockNumber  uint64             `json:"block_number"`
+	ConfigDigest types.ConfigDigest `json:"config_digest"`
+}
+
+type LatestTransmissionDetails struct {
+	LatestConfigDigest types.ConfigDigest `json:"latest_config_digest"`
+	Epoch              uint32             `json:"epoch"`
+	Round              uint8              `json:"round"`
+	LatestAnswer       Int                `json:"latest_answer"`
+	LatestTimestamp    uint32             `json:"latest_timestamp"`
+}
+
+type LatestConfigDigestAndEpoch struct {
+	ScanLogs     bool               `json:"scan_logs"`
+	ConfigDigest types.ConfigDigest `json:"config_digest"`
+	Epoch        uint32             `json:"epoch"`
+}
+
+// Round is the round data of the ocr2 and proxy-ocr2 contracts. The round ID of the proxy includes the phase ID.
+type Round struct {
+	RoundID               uint64 `json:"round_id"`
+	Answer                Int    `json:"answer"`
+	ObservationsTimestamp uint32 `json:"observations_timestamp"`
+	TransmissionTimestamp uint32 `json:"transmission_timestamp"`
+}
+
+type Billing struct {
+	// RecommendedGasPriceMicro is a decimal string.
+	RecommendedGasPriceMicro  string  `json:"recommended_gas_price_micro"`
+	ObservationPaymentGjuels  uint64  `json:"observation_payment_gjuels"`
+	TransmissionPaymentGjuels uint64  `json:"transmission_payment_gjuels"`
+	GasBase                   *uint64 `json:"gas_base"`
+	GasPerSignature           *uint64 `json:"gas_per_signature"`
+	// GasAdjustment is in percent.
+	GasAdjustment *uint8 `json:"gas_adjustment"`
+}
+
+type Validator struct {
+	Address  string `json:"address"`
+	GasLimit uint64 `json:"gas_limit"`
+}
+
+type Proposal struct {
+	Owner                 string   `json:"owner"`
+	Finalized             bool     `json:"finalized"`
+	Oracles               []Oracle `json:"oracles"`
+	F                     uint8    `json:"f"`
+	OffchainConfigVersion uint64   `json:"offchain_config_version"`
+	OffchainConfig        []byte   `json:"offchain_config"`
+}
+
+// Oracle is encoded as a (signer, transmitter, payee) tuple.
+type Oracle struct {
+	Signer      []byte
+	Transmitter string
+	Payee       string
+}
+
+func (o Oracle) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{o.Signer, o.Transmitter, o.Payee})
+}
+
+func (o *Oracle) UnmarshalJSON(b []byte) error {
+	var tuple []json.RawMessage
+	if err := json.Unmarshal(b, &tuple); err != nil {
+		return err
+	}
+	if len(tuple) != 3 {
+		return fmt.Errorf("expected (signer, transmitter, payee) tuple, but got %d elements", len(tuple))
+	}
+	for i, v := range []any{&o.Signer, &o.Transmitter, &o.Payee} {
+		if err := json.Unmarshal(tuple[i], v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OCR2Config is the config stored by the ocr2 contract, which is only partially exposed by queries.
+type OCR2Config struct {
+	LinkToken                 string             `json:"link_token"`
+	RequesterAccessController string             `json:"requester_access_controller"`
+	BillingAccessController   string             `json:"billing_access_controller"`
+	MinAnswer                 Int                `json:"min_answer"`
+	MaxAnswer                 Int                `json:"max_answer"`
+	Decimals                  uint8              `json:"decimals"`
+	Description               string             `json:"description"`
+	F                         uint8              `json:"f"`
+	N                         uint8              `json:"n"`
+	ConfigCount               uint32             `json:"config_count"`
+	LatestConfigDigest        types.ConfigDigest `json:"latest_config_digest"`
+	LatestConfigBlockNumber   uint64             `json:"latest_config_block_number"`
+	LatestAggregatorRoundID   uint32             `json:"latest_aggregator_round_id"`
+	Epoch                     uint32             `json:"epoch"`
+	Round                     uint8              `json:"round"`
+	Billing                   Billing            `json:"billing"`
+	Validator                 *Validator         `json:"validator"`
+}
+
+// Transmitter is the payment state of a transmitter.
+type Transmitter struct {
+	// Payment is the reimbursement in juels.
+	Payment     Int    `json:"payment"`
+	FromRoundID uint32 `json:"from_round_id"`
+}
+
+// Transmission is a transmitted answer, stored by round ID.
+type Transmission struct {
+	Answer                Int    `json:"answer"`
+	ObservationsTimestamp uint32 `json:"observations_timestamp"`
+	TransmissionTimestamp uint32 `json:"transmission_timestamp"`
+}
+
+// ProposedConfig is the onchain part of a config proposal.
+type ProposedConfig struct {
+	Signers      [][]byte `json:"signers"`
+	Transmitters []string `json:"transmitters"`
+	Payees       []string `json:"payees"`
+	F            uint8    `json:"f"`
+	// OnchainConfig is usually empty, since the contract computes it.
+	OnchainConfig []byte `json:"onchain_config"`
+}
+
+func (c *OCR2) LatestConfigDetails() (LatestConfigDetails, error) {
+	return query[LatestConfigDetails](c.Contract, "latest_config_details", struct{}{})
+}
+
+// Transmitters queries the addresses of the transmitters of the current config.
+func (c *OCR2) Transmitters() ([]string, error) {
+	resp, err := query[struct {
+		Addresses []string `json:"addresses"`
+	}](c.Contract, "transmitters", struct{}{})
+	return resp.Addresses, err
+}
+
+// LatestTransmissionDetails returns an error if there has not been a transmission yet.
+func (c *OCR2) LatestTransmissionDetails() (LatestTransmissionDetails, error) {
+	return query[LatestTransmissionDetails](c.Contract, "latest_transmission_details", struct{}{})
+}
+
+func (c *OCR2) LatestConfigDigestAndEpoch() (LatestConfigDigestAndEpoch, error) {
+	return query[LatestConfigDigestAndEpoch](c.Contract, "latest_config_digest_and_epoch", struct{}{})
+}
+
+func (c *OCR2) Description() (string, error) {
+	return query[string](c.Contract, "description", struct{}{})
+}
+
+func (c *OCR2) Decimals() (uint8, error) {
+	return query[uint8](c.Contract, "decimals", struct{}{})
+}
+
+func (c *OCR2) RoundData(roundID uint32) (Round, error) {
+	return query[Round](c.Contract, "round_data", struct {
+		RoundID uint32 `json:"round_id"`
+	}{roundID})
+}
+
+func (c *OCR2) LatestRoundData() (Round, error) {
+	return query[Round](c.Contract, "latest_round_data", struct{}{})
+}
+
+func (c *OCR2) LinkToken() (string, error) {
+	return query[string](c.Contract, "link_token", struct{}{})
+}
+
+func (c *OCR2) Billing() (Billing, error) {
+	return query[Billing](c.Contract, "billing", struct{}{})
+}
+
+func (c *OCR2) BillingAccessController() (string, error) {
+	return query[string](c.Contract, "billing_access_controller", struct{}{})
+}
+
+func (c *OCR2) RequesterAccessController() (string, error) {
+	return query[string](c.Contract, "requester_access_controller", struct{}{})
+}
+
+// OwedPayment queries the juels owed to transmitter.
+func (c *OCR2) OwedPayment(transmitter string) (Int, error) {
+	return query[Int](c.Contract, "owed_payment", struct {
+		Transmitter string `json:"transmitter"`
+	}{transmitter})
+}
+
+// LinkAvailableForPayment queries the juels held by the contract in excess of what it owes, which may be negative.
+func (c *OCR2) LinkAvailableForPayment() (Int, error) {
+	resp, err := query[struct {
+		Amount Int `json:"amount"`
+	}](c.Contract, "link_available_for_payment", struct{}{})
+	return resp.Amount, err
+}
+
+func (c *OCR2) OracleObservationCount(transmitter string) (uint32, error) {
+	return query[uint32](c.Contract, "oracle_observation_count", struct {
+		Transmitter string `json:"transmitter"`
+	}{transmitter})
+}
+
+func (c *OCR2) Proposal(id uint64) (Proposal, error) {
+	return query[Proposal](c.Contract, "proposal", struct {
+		ID string `json:"id"`
+	}{uint128(id)})
+}
+
+func (c *OCR2) Version() (string, error) {
+	return query[string](c.Contract, "version", struct{}{})
+}
+
+// Config reads the config stored by the contract.
+func (c *OCR2) Config() (OCR2Config, error) {
+	return raw[OCR2Config](c.Contract, ItemKey("config"))
+}
+
+// Transmitter reads the payment state of transmitter.
+func (c *OCR2) Transmitter(transmitter string) (Transmitter, error) {
+	return raw[Transmitter](c.Contract, MapKey("transmitters", []byte(transmitter)))
+}
+
+// Payee reads the address receiving the payments of transmitter.
+func (c *OCR2) Payee(transmitter string) (string, error) {
+	return raw[string](c.Contract, MapKey("payees", []byte(transmitter)))
+}
+
+// ProposedPayee reads the address proposed to receive the payments of transmitter.
+func (c *OCR2) ProposedPayee(transmitter string) (string, error) {
+	return raw[string](c.Contract, MapKey("proposed_payees", []byte(transmitter)))
+}
+
+// Transmission reads the transmission of the round with roundID.
+func (c *OCR2) Transmission(roundID uint32) (Transmission, error) {
+	return raw[Transmission](c.Contract, MapKey("transmissions", Uint32Key(roundID)))
+}
+
+// BeginProposalMsg returns a msg beginning a config proposal. The ID of the proposal is emitted in an event.
+func (c *OCR2) BeginProposalMsg(sender sdk.AccAddress) (*wasmtypes.MsgExecuteContract, error) {
+	return c.execute(sender, "begin_proposal", nil)
+}
+
+func (c *OCR2) ClearProposalMsg(sender sdk.AccAddress, id uint64) (*wasmtypes.MsgExecuteContract, error) {
+	return c.execute(sender, "clear_proposal", struct {
+		ID string `json:"id"`
+	}{uint128(id)})
+}
+
+func (c *OCR2) FinalizeProposalMsg(sender sdk.AccAddress, id uint64) (*wasmtypes.MsgExecuteContract, error) {
+	return c.execute(sender, "finalize_proposal", struct {
+		ID string `json:"id"`
+	}{uint128(id)})
+}
+
+// AcceptProposalMsg returns a msg accepting the finalized proposal id, whose digest must match.
+func (c *OCR2) AcceptProposalMsg(sender sdk.AccAddress, id uint64, digest []byte) (*wasmtypes.MsgExecuteContract, error) {
+	return c.execute(sender, "accept_proposal", struct {
+		ID     string `json:"id"`
+		Digest []byte `json:"digest"`
+	}{uint128(id), digest})
+}
+
+func (c *OCR2) ProposeConfigMsg(sender sdk.AccAddress, id uint64, config ProposedConfig) (*wasmtypes.MsgExecuteContract, error) {
+	return c.execute(sender, "propose_config", struct {
+		ID string `json:"id"`
+		ProposedConfig
+	}{uint128(id), config})
+}
+
+func (c *OCR2) ProposeOffchainConfigMsg(sender sdk.AccAddress, id uint64, version uint64, config []byte) (*wasmtypes.MsgExecuteContract, error) {
+	return c.execute(sender, "propose_offchain_config", struct {
+		ID                    string `json:"id"`
+		OffchainConfigVersion uint64 `json:"offchain_config_version"`
+		OffchainConfig        []byte `json:"offchain_config"`
+	}{uint128(id), version, config})
+}
+
+// TransmitMsg returns a msg transmitting a signed report.
+func (c *OCR2) TransmitMsg(sender sdk.AccAddress, reportContext []byte, report []byte, signatures [][]byte) (*wasmtypes.MsgExecuteContract, error) {
+	return c.execute(sender, "transmit", struct {
+		ReportContext []byte   `json:"report_context"`
+		Report        []byte   `json:"report"`
+		Signatures    [][]byte `json:"signatures"`
+	}{reportContext, report, signatures})
+}
+
+func (c *OCR2) RequestNewRoundMsg(sender sdk.AccAddress) (*wasmtypes.MsgExecuteContract, error) {
+	return c.execute(sender, "request_new_round", nil)
+}
+
+func (c *OCR2) SetBillingMsg(sender sdk.AccAddress, billing Billing) (*wasmtypes.MsgExecuteContract, error) {
+	return c.execute(sender, "set_billing", struct {
+		Config Billing `json:"config"`
+	}{billing})
+}
+
+// SetValidatorConfigMsg returns a msg setting the validator, which is removed if nil.
+func (c *OCR2) SetValidatorConfigMsg(sender sdk.AccAddress, validator *Validator) (*wasmtypes.MsgExecuteContract, error) {
+	return c.execute(sender, "set_validator_config", struct {
+		Config *Validator `json:"config"`
+	}{validator})
+}
+
+func (c *OCR2) SetBillingAccessControllerMsg(sender sdk.AccAddress, accessController string) (*wasmtypes.MsgExecuteContract, error) {
+	return c.execute(sender, "set_billing_access_controller", struct {
+		AccessController string `json:"access_controller"`
+	}{accessController})
+}
+
+func (c *OCR2) SetRequesterAccessControllerMsg(sender sdk.AccAddress, accessController string) (*wasmtypes.MsgExecuteContract, error) {
+	return c.execute(sender, "set_requester_access_controller", struct {
+		AccessController string `json:"access_controller"`
+	}{accessController})
+}
+
+func (c *OCR2) WithdrawPaymentMsg(sender sdk.AccAddress, transmitter string) (*wasmtypes.MsgExecuteContract, error) {
+	return c.execute(sender, "withdraw_payment", struct {
+		Transmitter string `json:"transmitter"`
+	}{transmitter})
+}
+
+func (c *OCR2) WithdrawFundsMsg(sender sdk.AccAddress, recipient string, amount *big.Int) (*wasmtypes.MsgExecuteContract, error) {
+	return c.execute(sender, "withdraw_funds", struct {
+		Recipient string `json:"recipient"`
+		Amount    Int    `json:"amount"`
+	}{recipient, Int{amount}})
+}
+
+// SetLinkTokenMsg returns a msg switching to linkToken, which sends the balance of the current token to recipient.
+func (c *OCR2) SetLinkTokenMsg(sender sdk.AccAddress, linkToken string, recipient string) (*wasmtypes.MsgExecuteContract, error) {
+	return c.execute(sender, "set_link_token", struct {
+		LinkToken string `json:"link_token"`
+		Recipient string `json:"recipient"`
+	}{linkToken, recipient})
+}
+
+func (c *OCR2) TransferPayeeshipMsg(sender sdk.AccAddress, transmitter string, proposed string) (*wasmtypes.MsgExecuteContract, error) {
+	return c.execute(sender, "transfer_payeeship", struct {
+		Transmitter string `json:"transmitter"`
+		Proposed    string `json:"proposed"`
+	}{transmitter, proposed})
+}
+
+func (c *OCR2) AcceptPayeeshipMsg(sender sdk.AccAddress, transmitter string) (*wasmtypes.MsgExecuteContract, error) {
+	return c.execute(sender, "accept_payeeship", struct {
+		Transmitter string `json:"transmitter"`
+	}{transmitter})
+}
@@ -0,0 +1,96 @@
+package contracts
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProxyOCR2 is a client of the proxy-ocr2 contract, which proxies reads to the current ocr2 aggregator.
+type ProxyOCR2 struct {
+	Contract
+}
+
+// NewProxyOCR2 returns a client of the proxy-ocr2 contract at address.
+func NewProxyOCR2(address sdk.AccAddress, querier Querier, opts ...Option) *ProxyOCR2 {
+	return &ProxyOCR2{newContract(address, querier, opts)}
+}
+
+// Phase is an aggregator of the proxy, along with its phase ID.
+type Phase struct {
+	ID              uint16 `json:"id"`
+	ContractAddress string `json:"contract_address"`
+}
+
+func (c *ProxyOCR2) Decimals() (uint8, error) {
+	return query[uint8](c.Contract, "decimals", struct{}{})
+}
+
+func (c *ProxyOCR2) Version() (string, error) {
+	return query[string](c.Contract, "version", struct{}{})
+}
+
+func (c *ProxyOCR2) Description() (string, error) {
+	return query[string](c.Contract, "description", struct{}{})
+}
+
+// RoundData queries a round by its proxy round ID, which includes the phase ID in the upper bits.
+func (c *ProxyOCR2) RoundData(roundID uint64) (Round, error) {
+	return query[Round](c.Contract, "round_data", struct {
+		RoundID uint64 `json:"round_id"`
+	}{roundID})
+}
+
+func (c *ProxyOCR2) LatestRoundData() (Round, error) {
+	return query[Round](c.Contract, "latest_round_data", struct{}{})
+}
+
+// ProposedRoundData queries a round of the proposed aggregator.
+func (c *ProxyOCR2) ProposedRoundData(roundID uint32) (Round, error) {
+	return query[Round](c.Contract, "proposed_round_data", struct {
+		RoundID uint32 `json:"round_id"`
+	}{roundID})
+}
+
+// ProposedLatestRoundData queries the latest round of the proposed aggregator.
+func (c *ProxyOCR2) ProposedLatestRoundData() (Round, error) {
+	return query[Round](c.Contract, "proposed_latest_round_data", struct{}{})
+}
+
+// Aggregator queries the address of the current aggregator.
+func (c *ProxyOCR2) Aggregator() (string, error) {
+	return query[string](c.Contract, "aggregator", struct{}{})
+}
+
+func (c *ProxyOCR2) PhaseID() (uint16, error) {
+	return query[uint16](c.Contract, "phase_id", struct{}{})
+}
+
+// PhaseAggregators queries the address of the aggregator of phaseID.
+func (c *ProxyOCR2) PhaseAggregators(phaseID uint16) (string, error) {
+	return query[string](c.Contract, "phase_aggregators", struct {
+		PhaseID uint16 `json:"phase_id"`
+	}{phaseID})
+}
+
+func (c *ProxyOCR2) ProposedAggregator() (string, error) {
+	return query[string](c.Contract, "proposed_aggregator", struct{}{})
+}
+
+// CurrentPhase reads the current phase, which is queryable only in two parts.
+func (c *ProxyOCR2) CurrentPhase() (Phase, error) {
+	return raw[Phase](c.Contract, ItemKey("current_phase"))
+}
+
+// ProposeContractMsg returns a msg proposing address as the next aggregator.
+func (c *ProxyOCR2) ProposeContractMsg(sender sdk.AccAddress, address string) (*wasmtypes.MsgExecuteContract, error) {
+	return c.execute(sender, "propose_contract", struct {
+		Address string `json:"address"`
+	}{address})
+}
+
+// ConfirmContractMsg returns a msg switching to the proposed aggregator at address, which starts a new phase.
+func (c *ProxyOCR2) ConfirmContractMsg(sender sdk.AccAddress, address string) (*wasmtypes.MsgExecuteContract, error) {
+	return c.execute(sender, "confirm_contract", struct {
+		Address string `json:"address"`
+	}{address})
+}
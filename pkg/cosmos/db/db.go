@@ -23,6 +23,8 @@ var (
 	Unstarted State = "unstarted"
 	// Started means included in a batch about to be broadcast.
 	// Valid next states: Broadcasted, Errored (sim fails)
+	// Batches refused for exceeding MaxFeePerTx or FeeBudget stay Started until they are sent or expire,
+	// and GetMsgs of the txm reports the refusal.
 	Started State = "started"
 	// Broadcasted means included in the mempool of a node.
 	// Valid next states: Confirmed (found onchain), Errored (tx expired waiting for confirmation)
@@ -2,16 +2,27 @@ package denom
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
 )
 
 // ConvertDecCoinToDenom is a helper for converting a DecCoin to a given denomination, rounded
-// down with the remainder discarded. Requires InitCosmosSdk to be called first to register
+// down with the remainder discarded. Requires params.RegisterDenoms to be called first to register
 // both the source and destinations token denominations, otherwise will return an error.
 func ConvertDecCoinToDenom(coin sdk.DecCoin, denom string) (sdk.Coin, error) {
-	decCoin, err := sdk.ConvertDecCoin(coin, denom)
+	decCoin, err := params.ConvertDecCoin(coin, denom)
 	if err != nil {
 		return sdk.Coin{}, err
 	}
 	truncated, _ := decCoin.TruncateDecimal()
 	return truncated, nil
 }
+
+// ConvertDecCoin converts a DecCoin, like a gas price, to a given denomination without rounding. Coins already in
+// denom are returned as is, so unregistered denominations like IBC assets are supported when no conversion is needed.
+func ConvertDecCoin(coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
+	if coin.Denom == denom {
+		return coin, nil
+	}
+	return params.ConvertDecCoin(coin, denom)
+}
@@ -65,3 +65,27 @@ func TestConvertDecCoinToDenomUnregistered(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertDecCoin(t *testing.T) {
+	tests := []struct {
+		price sdk.DecCoin
+		denom string
+		exp   string
+	}{
+		{sdk.NewDecCoinFromDec("uatom", sdk.MustNewDecFromStr("0.025")), "uatom", "0.025000000000000000uatom"},
+		{sdk.NewDecCoinFromDec("uatom", sdk.MustNewDecFromStr("0.025")), "natom", "25.000000000000000000natom"},
+		{sdk.NewDecCoinFromDec("uatom", sdk.MustNewDecFromStr("0.025")), "atom", "0.000000025000000000atom"},
+		// unregistered, but no conversion needed
+		{sdk.NewDecCoinFromDec("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", sdk.MustNewDecFromStr("0.1")), "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", "0.100000000000000000ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.price.String()+"->"+tt.denom, func(t *testing.T) {
+			got, err := ConvertDecCoin(tt.price, tt.denom)
+			require.NoError(t, err)
+			require.Equal(t, tt.exp, got.String())
+		})
+	}
+
+	_, err := ConvertDecCoin(sdk.NewDecCoinFromDec("uatom", sdk.MustNewDecFromStr("0.025")), "uosmo")
+	require.ErrorContains(t, err, "destination denom not registered: uosmo")
+}
@@ -0,0 +1,47 @@
+package cosmos
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/client"
+)
+
+var _ client.LightClientTrustStore = (*lightClientORM)(nil)
+
+// lightClientORM saves the latest verified header of the light client of a chain, in the
+// cosmos_light_client_headers table keyed by cosmos_chain_id, next to the cosmos_msgs of the chain. It is only used
+// with LightClientPersistTrust, since the table is migrated by the host.
+type lightClientORM struct {
+	chainID string
+	db      sqlutil.Queryer
+}
+
+func newLightClientORM(chainID string, db sqlutil.Queryer) *lightClientORM {
+	return &lightClientORM{chainID: chainID, db: db}
+}
+
+func (o *lightClientORM) LoadTrustedHeader(ctx context.Context) (int64, []byte, error) {
+	var header struct {
+		Height int64
+		Hash   []byte
+	}
+	err := o.db.GetContext(ctx, &header, `SELECT height, hash FROM cosmos_light_client_headers WHERE cosmos_chain_id = $1`, o.chainID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil, nil
+	} else if err != nil {
+		return 0, nil, err
+	}
+	return header.Height, header.Hash, nil
+}
+
+func (o *lightClientORM) SaveTrustedHeader(ctx context.Context, height int64, hash []byte) error {
+	_, err := o.db.ExecContext(ctx, `INSERT INTO cosmos_light_client_headers (cosmos_chain_id, height, hash, updated_at)
+	VALUES ($1, $2, $3, NOW())
+	ON CONFLICT (cosmos_chain_id) DO UPDATE SET height = EXCLUDED.height, hash = EXCLUDED.hash, updated_at = NOW()`,
+		o.chainID, height, hash)
+	return err
+}
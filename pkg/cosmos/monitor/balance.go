@@ -0,0 +1,209 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/client"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
+)
+
+// Config is read on each poll, so updates apply without a restart.
+type Config interface {
+	BalancePollPeriod() time.Duration
+	Bech32Prefix() string
+	FeeBudgetPeriod() time.Duration
+	GasToken() string
+	LowBalanceThreshold() sdk.Dec
+}
+
+// Accounts are the monitored accounts, e.g. a txm.Txm.
+type Accounts interface {
+	// Accounts returns the bech32 addresses of the keystore accounts.
+	Accounts() ([]string, error)
+	// FeeBudgetUsage returns the fees paid by each account within the last FeeBudgetPeriod, in the gas token.
+	FeeBudgetUsage() map[string]sdk.Dec
+}
+
+// AccountBalance is the last balance read of an account.
+type AccountBalance struct {
+	Balance sdk.Coin
+	// Runway is how long the balance lasts at the fee rate of the last FeeBudgetPeriod,
+	// or zero if the account has not paid fees in that period.
+	Runway time.Duration
+}
+
+// BalanceMonitor periodically reads the gas token balance of the keystore accounts, and reports it as metrics.
+// Accounts holding less than the LowBalanceThreshold are reported as unhealthy, so they can be topped up
+// before their txs fail.
+type BalanceMonitor struct {
+	services.StateMachine
+	chainID  string
+	cfg      Config
+	accounts Accounts
+	reader   func() (client.Reader, error)
+	lggr     logger.Logger
+
+	mu       sync.RWMutex
+	balances map[string]AccountBalance
+
+	stop services.StopChan
+	wg   sync.WaitGroup
+}
+
+// NewBalanceMonitor returns a BalanceMonitor of accounts, which reads from the clients returned by reader.
+func NewBalanceMonitor(chainID string, cfg Config, accounts Accounts, reader func() (client.Reader, error), lggr logger.Logger) *BalanceMonitor {
+	return &BalanceMonitor{
+		chainID:  chainID,
+		cfg:      cfg,
+		accounts: accounts,
+		reader:   reader,
+		lggr:     logger.Named(lggr, "BalanceMonitor"),
+		balances: map[string]AccountBalance{},
+		stop:     make(services.StopChan),
+	}
+}
+
+func (m *BalanceMonitor) Name() string { return m.lggr.Name() }
+
+func (m *BalanceMonitor) Start(context.Context) error {
+	return m.StartOnce("BalanceMonitor", func() error {
+		m.wg.Add(1)
+		go m.run()
+		return nil
+	})
+}
+
+func (m *BalanceMonitor) Close() error {
+	return m.StopOnce("BalanceMonitor", func() error {
+		close(m.stop)
+		m.wg.Wait()
+		return nil
+	})
+}
+
+// HealthReport reports accounts below the LowBalanceThreshold as unhealthy.
+func (m *BalanceMonitor) HealthReport() map[string]error {
+	report := map[string]error{m.Name(): m.Healthy()}
+	threshold := m.cfg.LowBalanceThreshold()
+	if !threshold.IsPositive() {
+		return report
+	}
+	for account, b := range m.Balances() {
+		var err error
+		if sdk.NewDecFromInt(b.Balance.Amount).LT(threshold) {
+			err = fmt.Errorf("balance %s is below the threshold of %s%s", b.Balance, threshold, b.Balance.Denom)
+			if b.Runway > 0 {
+				err = fmt.Errorf("%w, and lasts %s at recent fee rates", err, b.Runway)
+			}
+		}
+		report[m.Name()+"."+account] = err
+	}
+	return report
+}
+
+// Balances returns the last balance read of each account.
+func (m *BalanceMonitor) Balances() map[string]AccountBalance {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	balances := make(map[string]AccountBalance, len(m.balances))
+	for account, b := range m.balances {
+		balances[account] = b
+	}
+	return balances
+}
+
+func (m *BalanceMonitor) run() {
+	defer m.wg.Done()
+	for {
+		m.poll()
+		select {
+		case <-m.stop:
+			return
+		case <-time.After(m.cfg.BalancePollPeriod()):
+		}
+	}
+}
+
+// poll reads the balances of all accounts. Accounts whose balance cannot be read keep their last balance.
+func (m *BalanceMonitor) poll() {
+	accounts, err := m.accounts.Accounts()
+	if err != nil {
+		m.lggr.Errorw("Failed to get keystore accounts", "err", err)
+		return
+	}
+	reader, err := m.reader()
+	if err != nil {
+		m.lggr.Errorw("Failed to get client", "err", err)
+		return
+	}
+	bech32 := params.Bech32Prefix(m.cfg.Bech32Prefix())
+	gasToken, period, threshold := m.cfg.GasToken(), m.cfg.FeeBudgetPeriod(), m.cfg.LowBalanceThreshold()
+	usage := m.accounts.FeeBudgetUsage()
+	previous := m.Balances()
+
+	balances := make(map[string]AccountBalance, len(accounts))
+	for _, account := range accounts {
+		addr, err := bech32.Parse(account)
+		if err != nil {
+			m.lggr.Errorw("Failed to parse account", "account", account, "err", err)
+			continue
+		}
+		balance, err := reader.Balance(addr, gasToken)
+		if err != nil {
+			m.lggr.Warnw("Failed to get balance", "account", account, "err", err)
+			if b, ok := previous[account]; ok {
+				balances[account] = b
+			}
+			continue
+		}
+		b := AccountBalance{Balance: *balance, Runway: runway(balance.Amount, usage[account], period)}
+		balances[account] = b
+
+		amount, _ := sdk.NewDecFromInt(balance.Amount).Float64()
+		promCosmosBalance.WithLabelValues(account, m.chainID, gasToken).Set(amount)
+		if b.Runway > 0 {
+			promCosmosBalanceRunway.WithLabelValues(account, m.chainID).Set(b.Runway.Seconds())
+		} else {
+			promCosmosBalanceRunway.DeleteLabelValues(account, m.chainID)
+		}
+		if threshold.IsPositive() && sdk.NewDecFromInt(balance.Amount).LT(threshold) {
+			m.lggr.Warnw("Account balance is low", "account", account, "balance", balance, "threshold", threshold, "runway", b.Runway)
+		}
+	}
+
+	m.mu.Lock()
+	m.balances = balances
+	m.mu.Unlock()
+	// forget accounts removed from the keystore
+	for account, b := range previous {
+		if _, ok := balances[account]; !ok {
+			promCosmosBalance.DeleteLabelValues(account, m.chainID, b.Balance.Denom)
+			promCosmosBalanceRunway.DeleteLabelValues(account, m.chainID)
+		}
+	}
+}
+
+// runway returns how long balance lasts when spending at the rate of spent per period, or zero if nothing was spent.
+func runway(balance sdk.Int, spent sdk.Dec, period time.Duration) time.Duration {
+	if spent.IsNil() || !spent.IsPositive() {
+		return 0
+	}
+	periods, err := sdk.NewDecFromInt(balance).Quo(spent).Float64()
+	if err != nil {
+		return 0
+	}
+	d := periods * float64(period)
+	if d >= math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return time.Duration(d)
+}
@@ -0,0 +1,102 @@
+package monitor
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/client"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/testutil/fakechain"
+)
+
+type testConfig struct {
+	threshold sdk.Dec
+}
+
+func (c *testConfig) BalancePollPeriod() time.Duration { return time.Minute }
+func (c *testConfig) Bech32Prefix() string             { return "wasm" }
+func (c *testConfig) FeeBudgetPeriod() time.Duration   { return time.Hour }
+func (c *testConfig) GasToken() string                 { return "ucosm" }
+func (c *testConfig) LowBalanceThreshold() sdk.Dec     { return c.threshold }
+
+type testAccounts struct {
+	mu       sync.Mutex
+	accounts []string
+	usage    map[string]sdk.Dec
+}
+
+func (a *testAccounts) Accounts() ([]string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.accounts, nil
+}
+
+func (a *testAccounts) FeeBudgetUsage() map[string]sdk.Dec {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.usage
+}
+
+func TestBalanceMonitor(t *testing.T) {
+	bech32 := params.Bech32Prefix("wasm")
+	rich, poor := sdk.AccAddress([]byte("rich________________")), sdk.AccAddress([]byte("poor________________"))
+	chain := fakechain.New(fakechain.Config{})
+	chain.Fund(rich, sdk.NewInt64Coin("ucosm", 1_000_000))
+	chain.Fund(poor, sdk.NewInt64Coin("ucosm", 1_000))
+
+	cfg := &testConfig{threshold: sdk.NewDec(10_000)}
+	accounts := &testAccounts{
+		accounts: []string{bech32.Address(rich), bech32.Address(poor)},
+		usage:    map[string]sdk.Dec{bech32.Address(rich): sdk.NewDec(500_000)},
+	}
+	m := NewBalanceMonitor("chain", cfg, accounts, func() (client.Reader, error) { return chain, nil }, logger.Test(t))
+	m.poll()
+
+	balances := m.Balances()
+	require.Len(t, balances, 2)
+	assert.Equal(t, AccountBalance{Balance: sdk.NewInt64Coin("ucosm", 1_000_000), Runway: 2 * time.Hour}, balances[bech32.Address(rich)])
+	assert.Equal(t, AccountBalance{Balance: sdk.NewInt64Coin("ucosm", 1_000)}, balances[bech32.Address(poor)])
+	assert.Equal(t, float64(1_000_000), testutil.ToFloat64(promCosmosBalance.WithLabelValues(bech32.Address(rich), "chain", "ucosm")))
+	assert.Equal(t, float64(7200), testutil.ToFloat64(promCosmosBalanceRunway.WithLabelValues(bech32.Address(rich), "chain")))
+
+	report := m.HealthReport()
+	assert.NoError(t, report[m.Name()+"."+bech32.Address(rich)])
+	assert.ErrorContains(t, report[m.Name()+"."+bech32.Address(poor)], "balance 1000ucosm is below the threshold of 10000")
+
+	t.Run("no threshold", func(t *testing.T) {
+		cfg.threshold = sdk.ZeroDec()
+		t.Cleanup(func() { cfg.threshold = sdk.NewDec(10_000) })
+		assert.Equal(t, map[string]error{m.Name(): m.Healthy()}, m.HealthReport())
+	})
+
+	t.Run("failed reads keep the last balance", func(t *testing.T) {
+		chain.FailCalls("Balance", errors.New("unreachable"))
+		m.poll()
+		assert.Equal(t, balances, m.Balances())
+	})
+
+	t.Run("removed accounts", func(t *testing.T) {
+		accounts.mu.Lock()
+		accounts.accounts = accounts.accounts[1:]
+		accounts.mu.Unlock()
+		m.poll()
+		assert.NotContains(t, m.Balances(), bech32.Address(rich))
+		assert.Equal(t, 0, testutil.CollectAndCount(promCosmosBalanceRunway))
+	})
+}
+
+func TestRunway(t *testing.T) {
+	assert.Zero(t, runway(sdk.NewInt(100), sdk.ZeroDec(), time.Hour))
+	assert.Zero(t, runway(sdk.NewInt(100), sdk.Dec{}, time.Hour))
+	assert.Equal(t, 30*time.Minute, runway(sdk.NewInt(50), sdk.NewDec(100), time.Hour))
+	assert.Equal(t, time.Duration(1<<63-1), runway(sdk.NewInt(1e18), sdk.MustNewDecFromStr("0.000000000000000001"), time.Hour))
+}
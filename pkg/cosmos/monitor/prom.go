@@ -0,0 +1,23 @@
+package monitor
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	promCosmosBalance = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "cosmos_balance",
+			Help: "Reports the gas token balance of each keystore account.",
+		},
+		[]string{"account", "chain_id", "denom"},
+	)
+	promCosmosBalanceRunway = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "cosmos_balance_runway_seconds",
+			Help: "Reports how long the balance of each keystore account lasts at the fee rate of the last FeeBudgetPeriod.",
+		},
+		[]string{"account", "chain_id"},
+	)
+)
@@ -0,0 +1,204 @@
+package cosmos
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"net/url"
+	"reflect"
+	"sync"
+	"time"
+
+	"go.uber.org/multierr"
+	"google.golang.org/grpc"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/client"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/config"
+)
+
+// servesReads returns whether n serves queries, which send-only nodes do not.
+func servesReads(n *config.Node) bool {
+	return n.NodeRole() != config.NodeRoleSendOnly
+}
+
+// servesBroadcasts returns whether n serves broadcasts, which all nodes do.
+func servesBroadcasts(*config.Node) bool {
+	return true
+}
+
+// servesHistory returns whether n serves height-pinned queries.
+func servesHistory(n *config.Node) bool {
+	return n.NodeRole() == config.NodeRoleArchive
+}
+
+// selectNode returns a random node among the nodes of the lowest priority which serve the request, or nil if none do.
+func selectNode(nodes config.Nodes, serves func(*config.Node) bool) (*config.Node, error) {
+	var candidates []*config.Node
+	for _, n := range nodes {
+		if !serves(n) {
+			continue
+		}
+		if len(candidates) > 0 {
+			if p, best := n.NodePriority(), candidates[0].NodePriority(); p > best {
+				continue
+			} else if p < best {
+				candidates = candidates[:0]
+			}
+		}
+		candidates = append(candidates, n)
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(len(candidates))))
+	if err != nil {
+		return nil, fmt.Errorf("could not generate a random node index: %w", err)
+	}
+	return candidates[i.Int64()], nil
+}
+
+// nodeTransport holds the state shared by the clients of a node.
+type nodeTransport struct {
+	node    config.Node // the config the transport was built with
+	limiter *client.RateLimiter
+	health  *client.NodeHealth
+	grpc    *grpc.ClientConn // nil unless the node has a GRPCURL
+}
+
+// unhealthyNodeRetryPeriod is how often unhealthy nodes are tried again, since only their own requests can find
+// them healthy again.
+const unhealthyNodeRetryPeriod = time.Minute
+
+// nodeTransports caches the transport of each node by name, and rebuilds it when the node config changes.
+type nodeTransports struct {
+	mu         sync.Mutex
+	transports map[string]*nodeTransport
+	lggr       logger.Logger
+	now        func() time.Time // time.Now if nil
+}
+
+func (ts *nodeTransports) get(n *config.Node) (*nodeTransport, error) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	name := *n.Name
+	if t, ok := ts.transports[name]; ok {
+		if reflect.DeepEqual(t.node, *n) {
+			return t, nil
+		}
+		// in flight requests of the old config fail, and are retried by their callers
+		ts.remove(name, t)
+	}
+	t := &nodeTransport{node: *n, health: &client.NodeHealth{}}
+	if n.RateLimit != nil {
+		t.limiter = client.NewRateLimiter(*n.RateLimit)
+	}
+	if n.GRPCURL != nil {
+		conn, err := client.DialGRPC((*url.URL)(n.GRPCURL).String(), n.NodeRequestTimeout(), t.limiter, n.Auth(), t.health)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dial gRPC of node %s: %w", name, err)
+		}
+		t.grpc = conn
+	}
+	if ts.transports == nil {
+		ts.transports = make(map[string]*nodeTransport)
+	}
+	ts.transports[name] = t
+	return t, nil
+}
+
+func (ts *nodeTransports) remove(name string, t *nodeTransport) {
+	delete(ts.transports, name)
+	if t.grpc != nil {
+		if err := t.grpc.Close(); err != nil {
+			ts.lggr.Warnw("Failed to close gRPC connection", "name", name, "err", err)
+		}
+	}
+}
+
+// healthy returns whether the last request to n reached it. Nodes without requests yet are healthy, and so are
+// nodes whose last request failed more than unhealthyNodeRetryPeriod ago, so that they are tried again.
+func (ts *nodeTransports) healthy(n *config.Node) bool {
+	ts.mu.Lock()
+	t, ok := ts.transports[*n.Name]
+	ts.mu.Unlock()
+	if !ok || !reflect.DeepEqual(t.node, *n) {
+		return true
+	}
+	failedAt := t.health.FailedAt()
+	if failedAt.IsZero() {
+		return true
+	}
+	now := time.Now
+	if ts.now != nil {
+		now = ts.now
+	}
+	return now().Sub(failedAt) >= unhealthyNodeRetryPeriod
+}
+
+// Close closes the connections of all nodes.
+func (ts *nodeTransports) Close() (err error) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	for name, t := range ts.transports {
+		delete(ts.transports, name)
+		if t.grpc != nil {
+			err = multierr.Append(err, t.grpc.Close())
+		}
+	}
+	return
+}
+
+// newNodeClient returns a client of node n, configured with its transport settings.
+func (c *chain) newNodeClient(n *config.Node, opts ...client.ClientOption) (*client.Client, error) {
+	t, err := c.transports.get(n)
+	if err != nil {
+		return nil, err
+	}
+	opts = append([]client.ClientOption{
+		client.WithBech32Prefix(c.cfg.Bech32Prefix()),
+		client.WithRateLimiter(t.limiter),
+		client.WithNodeHealth(t.health),
+		client.WithAuth(n.Auth()),
+		client.WithRetryConfig(client.RetryConfig{
+			Attempts:   int(c.cfg.RequestRetryAttempts()),
+			MinBackoff: c.cfg.RequestRetryMinBackoff(),
+			MaxBackoff: c.cfg.RequestRetryMaxBackoff(),
+		}),
+	}, opts...)
+	if t.grpc != nil {
+		opts = append(opts, client.WithGRPCConn(t.grpc))
+	}
+	if n.WebsocketURL != nil {
+		opts = append(opts, client.WithWebsocketURL((*url.URL)(n.WebsocketURL).String()))
+	}
+	return client.NewClient(c.id, (*url.URL)(n.TendermintURL).String(), n.NodeRequestTimeout(), logger.Named(c.lggr, "Client."+*n.Name), opts...)
+}
+
+// broadcastClients returns clients of all the healthy nodes serving broadcasts, for broadcast fan-out.
+// Should none be healthy, all of them are tried. Unhealthy nodes are tried again every unhealthyNodeRetryPeriod.
+func (c *chain) broadcastClients(nodes config.Nodes) ([]*client.Client, error) {
+	var all, healthy config.Nodes
+	for _, n := range nodes {
+		if servesBroadcasts(n) {
+			all = append(all, n)
+			if c.transports.healthy(n) {
+				healthy = append(healthy, n)
+			}
+		}
+	}
+	if len(healthy) == 0 {
+		c.lggr.Warnw("No healthy nodes to broadcast to, trying all of them", "nodes", len(all))
+		healthy = all
+	}
+	clients := make([]*client.Client, 0, len(healthy))
+	for _, n := range healthy {
+		bc, err := c.newNodeClient(n)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create broadcast client of node %s: %w", *n.Name, err)
+		}
+		clients = append(clients, bc)
+	}
+	return clients, nil
+}
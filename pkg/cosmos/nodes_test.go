@@ -0,0 +1,115 @@
+package cosmos
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	cosmosconfig "github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/config"
+)
+
+func testNode(name, role string, priority int64) *cosmosconfig.Node {
+	return &cosmosconfig.Node{
+		Name:          &name,
+		TendermintURL: config.MustParseURL("http://" + name + ":26657"),
+		Role:          &role,
+		Priority:      &priority,
+	}
+}
+
+func TestSelectNode(t *testing.T) {
+	nodes := cosmosconfig.Nodes{
+		testNode("backup", cosmosconfig.NodeRolePrimary, 1),
+		testNode("primary-1", cosmosconfig.NodeRolePrimary, 0),
+		testNode("primary-2", cosmosconfig.NodeRolePrimary, 0),
+		testNode("sender", cosmosconfig.NodeRoleSendOnly, 0),
+		testNode("archive", cosmosconfig.NodeRoleArchive, 2),
+	}
+	selected := func(serves func(*cosmosconfig.Node) bool) map[string]bool {
+		names := map[string]bool{}
+		for i := 0; i < 100; i++ {
+			n, err := selectNode(nodes, serves)
+			require.NoError(t, err)
+			names[*n.Name] = true
+		}
+		return names
+	}
+
+	assert.Equal(t, map[string]bool{"primary-1": true, "primary-2": true}, selected(servesReads))
+	assert.Equal(t, map[string]bool{"primary-1": true, "primary-2": true, "sender": true}, selected(servesBroadcasts))
+	assert.Equal(t, map[string]bool{"archive": true}, selected(servesHistory))
+
+	n, err := selectNode(nodes[:1], servesHistory)
+	require.NoError(t, err)
+	assert.Nil(t, n)
+}
+
+func TestNodeTransports(t *testing.T) {
+	ts := nodeTransports{lggr: logger.Test(t)}
+	node := testNode("node", cosmosconfig.NodeRolePrimary, 0)
+	node.RateLimit = new(int64)
+	*node.RateLimit = 10
+	node.GRPCURL = config.MustParseURL("http://node:9090")
+
+	t1, err := ts.get(node)
+	require.NoError(t, err)
+	assert.NotNil(t, t1.limiter)
+	assert.NotNil(t, t1.grpc)
+
+	// reused until the node config changes
+	t2, err := ts.get(node)
+	require.NoError(t, err)
+	assert.Same(t, t1, t2)
+
+	updated := *node
+	updated.GRPCURL = nil
+	t3, err := ts.get(&updated)
+	require.NoError(t, err)
+	assert.NotSame(t, t1, t3)
+	assert.Nil(t, t3.grpc)
+
+	require.NoError(t, ts.Close())
+}
+
+func TestNodeTransports_Healthy(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(srv.Close)
+	chainID := "chain-1"
+	cfg := &cosmosconfig.TOMLConfig{ChainID: &chainID}
+	cfg.Chain.SetDefaults()
+	ch := &chain{id: chainID, cfg: cosmosconfig.NewReloadable(cfg), lggr: logger.Test(t)}
+	ch.transports.lggr = ch.lggr
+	var now time.Time
+	ch.transports.now = func() time.Time { return now }
+	t.Cleanup(func() { require.NoError(t, ch.transports.Close()) })
+
+	node := testNode("node", cosmosconfig.NodeRoleSendOnly, 0)
+	node.TendermintURL = config.MustParseURL(srv.URL)
+	assert.True(t, ch.transports.healthy(node), "no requests yet")
+
+	c, err := ch.newNodeClient(node)
+	require.NoError(t, err)
+	_, err = c.Status()
+	require.Error(t, err)
+	now = time.Now()
+	assert.False(t, ch.transports.healthy(node))
+	clients, err := ch.broadcastClients(cosmosconfig.Nodes{node, testNode("other", cosmosconfig.NodeRolePrimary, 0)})
+	require.NoError(t, err)
+	assert.Len(t, clients, 1)
+
+	// tried again after a while, since only its own requests can find it healthy again
+	now = now.Add(unhealthyNodeRetryPeriod)
+	assert.True(t, ch.transports.healthy(node))
+	clients, err = ch.broadcastClients(cosmosconfig.Nodes{node, testNode("other", cosmosconfig.NodeRolePrimary, 0)})
+	require.NoError(t, err)
+	assert.Len(t, clients, 2)
+}
@@ -43,12 +43,16 @@ var config = makeEncodingConfig()
 
 var initOnce sync.Once
 
-// Initialize the cosmos sdk at most one time
+// Initialize the cosmos sdk at most one time, and register the denominations of token. The bech32 prefix of the first
+// call wins, but the denominations of every token are registered, see RegisterDenoms.
 func InitCosmosSdk(bech32Prefix, token string) {
-	initOnce.Do(func() { initCosmosSdk(bech32Prefix, token) })
+	initOnce.Do(func() { initCosmosSdk(bech32Prefix) })
+	if err := RegisterDenoms(token); err != nil {
+		panic(err)
+	}
 }
 
-func initCosmosSdk(bech32Prefix, token string) {
+func initCosmosSdk(bech32Prefix string) {
 	// copied from wasmd https://github.com/CosmWasm/wasmd/blob/88e01a98ab8a87b98dc26c03715e6aef5c92781b/app/app.go#L163-L174
 	// NOTE: Bech32 is configured globally, blocked on https://github.com/cosmos/cosmos-sdk/issues/13140
 	var (
@@ -79,21 +83,64 @@ func initCosmosSdk(bech32Prefix, token string) {
 	sdkConfig.SetBech32PrefixForValidator(bech32PrefixValAddr, bech32PrefixValPub)
 	sdkConfig.SetBech32PrefixForConsensusNode(bech32PrefixConsAddr, bech32PrefixConsPub)
 	sdkConfig.Seal()
+}
+
+// denomsMu synchronizes the sdk denomination registry, which is global and not synchronized,
+// since each chain registers the denominations of its gas token.
+var denomsMu sync.RWMutex
+
+type denomUnit struct {
+	denom string
+	unit  sdk.Dec // relative to the token
+}
+
+// tokenDenoms returns the denominations of token: the token itself, and its milli, micro and nano denominations.
+func tokenDenoms(token string) []denomUnit {
+	return []denomUnit{
+		{token, sdk.OneDec()},
+		{"m" + token, sdk.NewDecWithPrec(1, 3)},
+		{"u" + token, sdk.NewDecWithPrec(1, 6)},
+		{"n" + token, sdk.NewDecWithPrec(1, 9)},
+	}
+}
 
-	for _, d := range []struct {
-		denom    string
-		decimals int64
-	}{
-		{token, 0},
-		{"m" + token, 3},
-		{"u" + token, 6},
-		{"n" + token, 9},
-	} {
-		dec := sdk.NewDecWithPrec(1, d.decimals)
-		if err := sdk.RegisterDenom(d.denom, dec); err != nil {
-			panic(fmt.Errorf("failed to register denomination %q: %w", d.denom, err))
+// RegisterDenoms registers the denominations of token with the sdk, so that they can be converted with
+// ConvertDecCoin. Denominations registered for another token, like the "ucosm" of both "cosm" and "ucosm",
+// are kept, and the others are registered with units consistent with them.
+func RegisterDenoms(token string) error {
+	denomsMu.Lock()
+	defer denomsMu.Unlock()
+	var unit sdk.Dec // of token
+	for _, d := range tokenDenoms(token) {
+		registered, ok := sdk.GetDenomUnit(d.denom)
+		if !ok {
+			continue
 		}
+		if implied := registered.Quo(d.unit); unit.IsNil() {
+			unit = implied
+		} else if !unit.Equal(implied) {
+			return fmt.Errorf("denomination %q of %s is already registered with a conflicting unit", d.denom, token)
+		}
+	}
+	if unit.IsNil() {
+		unit = sdk.OneDec()
 	}
+	for _, d := range tokenDenoms(token) {
+		if _, ok := sdk.GetDenomUnit(d.denom); ok {
+			continue
+		}
+		if err := sdk.RegisterDenom(d.denom, unit.Mul(d.unit)); err != nil {
+			return fmt.Errorf("failed to register denomination %q: %w", d.denom, err)
+		}
+	}
+	return nil
+}
+
+// ConvertDecCoin is sdk.ConvertDecCoin, synchronized with RegisterDenoms.
+func ConvertDecCoin(coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
+	denomsMu.RLock()
+	defer denomsMu.RUnlock()
+	return sdk.ConvertDecCoin(coin, denom)
 }
 
 func NewClientContext() client.Context {
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestInitCosmosSdk(t *testing.T) {
@@ -12,13 +13,68 @@ func TestInitCosmosSdk(t *testing.T) {
 	assert.NotPanics(t, func() { InitCosmosSdk("wasm", "atom") })
 	assert.NotPanics(t, func() { InitCosmosSdk("notwasm", "cosmos") })
 	// calling the internal implementation panics when called a second time
-	assert.Panics(t, func() { initCosmosSdk("wasm", "cosmos") })
+	assert.Panics(t, func() { initCosmosSdk("wasm") })
 
-	// first call to Init wins
+	// first call to Init wins the bech32 prefix, but the denominations of both tokens are registered
 	sdkConfig := sdk.GetConfig()
 	assert.Equal(t, sdkConfig.GetBech32AccountAddrPrefix(), "wasm")
 	_, ok := sdk.GetDenomUnit("atom")
 	assert.True(t, ok)
-	_, ok = sdk.GetDenomUnit("cosmos")
-	assert.False(t, ok)
+	_, ok = sdk.GetDenomUnit("ncosmos")
+	assert.True(t, ok)
+}
+
+func TestRegisterDenoms(t *testing.T) {
+	require.NoError(t, RegisterDenoms("osmo"))
+	require.NoError(t, RegisterDenoms("osmo"))
+	converted, err := ConvertDecCoin(sdk.NewDecCoinFromDec("uosmo", sdk.MustNewDecFromStr("0.025")), "nosmo")
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDecCoinFromDec("nosmo", sdk.MustNewDecFromStr("25")), converted)
+
+	// denominations shared with another token keep their unit
+	require.NoError(t, RegisterDenoms("uosmo"))
+	converted, err = ConvertDecCoin(sdk.NewDecCoin("uosmo", sdk.NewInt(1)), "nuosmo")
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDecCoin("nuosmo", sdk.NewInt(1_000_000_000)), converted)
+	converted, err = ConvertDecCoin(sdk.NewDecCoin("uosmo", sdk.NewInt(1)), "nosmo")
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDecCoin("nosmo", sdk.NewInt(1_000)), converted)
+
+	// ufoo and mfoo are both registered with unit 1, which no unit of foo is consistent with
+	require.NoError(t, RegisterDenoms("ufoo"))
+	require.NoError(t, RegisterDenoms("mfoo"))
+	require.ErrorContains(t, RegisterDenoms("foo"), "already registered with a conflicting unit")
+}
+
+func TestBech32Prefix(t *testing.T) {
+	addr := sdk.AccAddress("test_address________")
+	wasm, inj := Bech32Prefix("wasm"), Bech32Prefix("inj")
+
+	wasmAddr, injAddr := wasm.Address(addr), inj.Address(addr)
+	assert.Regexp(t, "^wasm1", wasmAddr)
+	assert.Regexp(t, "^inj1", injAddr)
+	assert.Empty(t, wasm.Address(nil))
+
+	parsed, err := inj.Parse(injAddr)
+	assert.NoError(t, err)
+	assert.Equal(t, addr, parsed)
+
+	// each prefix only parses its own addresses
+	_, err = inj.Parse(wasmAddr)
+	assert.Error(t, err)
+	_, err = inj.Parse("")
+	assert.Error(t, err)
+
+	for _, address := range []string{wasmAddr, injAddr} {
+		parsed, err = ParseAnyPrefix(address)
+		assert.NoError(t, err)
+		assert.Equal(t, addr, parsed)
+	}
+	_, err = ParseAnyPrefix("")
+	assert.Error(t, err)
+
+	assert.True(t, SameAddress(wasmAddr, addr))
+	assert.True(t, SameAddress(injAddr, addr))
+	assert.False(t, SameAddress(injAddr, sdk.AccAddress("other_address_______")))
+	assert.False(t, SameAddress("not an address", addr))
 }
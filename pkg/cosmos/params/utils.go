@@ -1,12 +1,15 @@
 package params
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
+	"strings"
 
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"golang.org/x/crypto/ripemd160" //nolint: staticcheck
 )
@@ -33,3 +36,63 @@ func CreateBech32Address(pubKey, accountPrefix string) (string, error) {
 	}
 	return bech32Addr, nil
 }
+
+// Bech32Prefix encodes and parses the account addresses of a chain, independent of the process-global sdk.Config,
+// so that chains with different prefixes can be served side by side. The empty prefix falls back to sdk.Config.
+type Bech32Prefix string
+
+// Address returns the bech32 encoding of addr.
+func (p Bech32Prefix) Address(addr sdk.AccAddress) string {
+	if p == "" {
+		return addr.String()
+	}
+	if addr.Empty() {
+		return ""
+	}
+	bech32Addr, err := bech32.ConvertAndEncode(string(p), addr)
+	if err != nil {
+		// like sdk.AccAddress.String, since only invalid prefixes fail
+		panic(err)
+	}
+	return bech32Addr
+}
+
+// Parse returns the account address encoded in address, which must have the prefix.
+func (p Bech32Prefix) Parse(address string) (sdk.AccAddress, error) {
+	if p == "" {
+		return sdk.AccAddressFromBech32(address)
+	}
+	if strings.TrimSpace(address) == "" {
+		return sdk.AccAddress{}, errors.New("empty address string is not allowed")
+	}
+	bz, err := sdk.GetFromBech32(address, string(p))
+	if err != nil {
+		return nil, err
+	}
+	if err = sdk.VerifyAddressFormat(bz); err != nil {
+		return nil, err
+	}
+	return bz, nil
+}
+
+// ParseAnyPrefix returns the account address encoded in address, whatever its prefix. It is meant for types shared by
+// chains with different prefixes, like msgs, whose prefix is checked against that of their chain when they are enqueued.
+func ParseAnyPrefix(address string) (sdk.AccAddress, error) {
+	if strings.TrimSpace(address) == "" {
+		return sdk.AccAddress{}, errors.New("empty address string is not allowed")
+	}
+	_, bz, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return nil, err
+	}
+	if err = sdk.VerifyAddressFormat(bz); err != nil {
+		return nil, err
+	}
+	return bz, nil
+}
+
+// SameAddress returns whether the bech32 address encodes addr, whatever its prefix.
+func SameAddress(address string, addr sdk.AccAddress) bool {
+	_, bz, err := bech32.DecodeAndConvert(address)
+	return err == nil && bytes.Equal(bz, addr)
+}
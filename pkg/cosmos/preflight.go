@@ -0,0 +1,198 @@
+package cosmos
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.uber.org/multierr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/client"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/config"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
+)
+
+// Node states reported by ListNodeStatuses.
+const (
+	NodeStateUnknown     = "Unknown" // preflight checks pending
+	NodeStateAlive       = "Alive"
+	NodeStateUnreachable = "Unreachable"
+	NodeStateInvalid     = "Invalid"
+)
+
+// errNodeUnreachable is returned by checkNode when the node status could not be read.
+var errNodeUnreachable = errors.New("node unreachable")
+
+// unreachableNodeRecheckPeriod is how often nodes which were unreachable are checked again, since they may just
+// have been down when last checked.
+const unreachableNodeRecheckPeriod = time.Minute
+
+// preflightAddress is the sample address encoded by nodes to check their bech32 prefix.
+var preflightAddress = sdk.AccAddress(make([]byte, 20))
+
+// checkNode checks that the node behind reader serves chainID, encodes addresses with the configured bech32 prefix,
+// knows the gas token and accepts the fallback gas price. Send-only nodes only have their chain ID checked,
+// since they may not serve queries.
+func checkNode(reader client.Reader, n *config.Node, chainID string, cfg config.Config) error {
+	s, err := reader.Status()
+	if err != nil {
+		return fmt.Errorf("%w: %w", errNodeUnreachable, err)
+	}
+	if network := s.NodeInfo.Network; network != chainID {
+		return fmt.Errorf("node serves chain %s instead of %s", network, chainID)
+	}
+	if !servesReads(n) {
+		return nil
+	}
+
+	var merr error
+	if addr, err := reader.AddressString(preflightAddress); err != nil {
+		merr = multierr.Append(merr, fmt.Errorf("failed to encode sample address: %w", err))
+	} else if want := params.Bech32Prefix(cfg.Bech32Prefix()).Address(preflightAddress); addr != want {
+		merr = multierr.Append(merr, fmt.Errorf("node encodes addresses as %s instead of %s: Bech32Prefix mismatch", addr, want))
+	}
+
+	gasToken := cfg.GasToken()
+	// native denoms often have no metadata, but have a supply
+	if _, err := reader.DenomMetadata(gasToken); errors.Is(err, client.ErrNotFound) {
+		if supply, err := reader.SupplyOf(gasToken); err != nil {
+			merr = multierr.Append(merr, fmt.Errorf("failed to get supply of gas token %s: %w", gasToken, err))
+		} else if supply.IsZero() {
+			merr = multierr.Append(merr, fmt.Errorf("unknown gas token %s: no denom metadata or supply", gasToken))
+		}
+	} else if err != nil {
+		merr = multierr.Append(merr, fmt.Errorf("failed to get denom metadata of gas token %s: %w", gasToken, err))
+	}
+
+	// nodes without the node service do not expose their minimum gas prices
+	if prices, err := reader.MinimumGasPrices(); err != nil && status.Code(err) != codes.Unimplemented {
+		merr = multierr.Append(merr, fmt.Errorf("failed to get minimum gas prices: %w", err))
+	} else if err == nil && len(prices) > 0 {
+		accepted := false
+		for _, denom := range cfg.FeeDenoms() {
+			accepted = accepted || prices.AmountOf(denom).IsPositive()
+		}
+		if !accepted {
+			merr = multierr.Append(merr, fmt.Errorf("node only accepts fees in %s, none of the fee denoms %v", prices, cfg.FeeDenoms()))
+		}
+		if min := prices.AmountOf(gasToken); cfg.FallbackGasPrice().LT(min) {
+			merr = multierr.Append(merr, fmt.Errorf("FallbackGasPrice %s is below the minimum gas price %s%s of the node", cfg.FallbackGasPrice(), min, gasToken))
+		}
+	}
+	return merr
+}
+
+// nodeStateOf returns the node state reported for the result of checkNode.
+func nodeStateOf(err error) string {
+	switch {
+	case err == nil:
+		return NodeStateAlive
+	case errors.Is(err, errNodeUnreachable):
+		return NodeStateUnreachable
+	}
+	return NodeStateInvalid
+}
+
+// nodeCheck is the result of the preflight checks of a node config.
+type nodeCheck struct {
+	node config.Node
+	err  error
+}
+
+// nodeChecks holds the results of the preflight checks of each node by name.
+type nodeChecks struct {
+	mu      sync.RWMutex
+	results map[string]nodeCheck
+}
+
+func (cs *nodeChecks) set(n *config.Node, err error) {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	if cs.results == nil {
+		cs.results = make(map[string]nodeCheck)
+	}
+	cs.results[*n.Name] = nodeCheck{node: *n, err: err}
+}
+
+// get returns the result of the checks of n, unless they are pending or were run with another config.
+func (cs *nodeChecks) get(n *config.Node) (err error, ok bool) {
+	cs.mu.RLock()
+	defer cs.mu.RUnlock()
+	r, ok := cs.results[*n.Name]
+	if !ok || !reflect.DeepEqual(r.node, *n) {
+		return nil, false
+	}
+	return r.err, true
+}
+
+// unreachable returns the nodes whose last checks with their current config failed to reach them.
+func (cs *nodeChecks) unreachable(nodes config.Nodes) config.Nodes {
+	var unreachable config.Nodes
+	for _, n := range nodes {
+		if err, ok := cs.get(n); ok && errors.Is(err, errNodeUnreachable) {
+			unreachable = append(unreachable, n)
+		}
+	}
+	return unreachable
+}
+
+// state returns the node state of n.
+func (cs *nodeChecks) state(n *config.Node) string {
+	err, ok := cs.get(n)
+	if !ok {
+		return NodeStateUnknown
+	}
+	if err != nil {
+		return fmt.Sprintf("%s: %v", nodeStateOf(err), err)
+	}
+	return NodeStateAlive
+}
+
+// checkNodes runs the preflight checks of nodes in the background, to not block on unreachable nodes.
+func (c *chain) checkNodes(nodes config.Nodes) {
+	for _, n := range nodes {
+		n := n
+		c.wg.Add(1)
+		go func() {
+			defer c.wg.Done()
+			c.checkNode(n)
+		}()
+	}
+}
+
+// checkNode runs the preflight checks of n, and records the result.
+func (c *chain) checkNode(n *config.Node) {
+	reader, err := c.newNodeClient(n)
+	if err == nil {
+		err = checkNode(reader, n, c.id, c.cfg)
+	}
+	if err != nil {
+		c.lggr.Errorw("Node failed preflight checks", "name", *n.Name, "state", nodeStateOf(err), "err", err)
+	} else {
+		c.lggr.Infow("Node passed preflight checks", "name", *n.Name)
+	}
+	c.checks.set(n, err)
+}
+
+// recheckNodes checks the nodes which were unreachable again every unreachableNodeRecheckPeriod, until stopped.
+// Nodes are checked one at a time, so that a node is never checked again while its last check is still running.
+func (c *chain) recheckNodes() {
+	defer c.wg.Done()
+	ticker := time.NewTicker(unreachableNodeRecheckPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.stop:
+			return
+		case <-ticker.C:
+			for _, n := range c.checks.unreachable(c.cfg.Current().Nodes) {
+				c.checkNode(n)
+			}
+		}
+	}
+}
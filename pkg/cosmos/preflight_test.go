@@ -0,0 +1,92 @@
+package cosmos
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/client"
+	cosmosconfig "github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/config"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/testutil/fakechain"
+)
+
+func TestCheckNode(t *testing.T) {
+	chainID := fakechain.DefaultChainID
+	cfg := &cosmosconfig.TOMLConfig{ChainID: &chainID}
+	cfg.Chain.SetDefaults() // wasm prefix, ucosm gas token, 0.015 fallback gas price
+	primary := testNode("primary", cosmosconfig.NodeRolePrimary, 0)
+	sender := testNode("sender", cosmosconfig.NodeRoleSendOnly, 0)
+	funded := func(fc fakechain.Config) *fakechain.Chain {
+		c := fakechain.New(fc)
+		c.Fund(preflightAddress, sdk.NewInt64Coin("ucosm", 1))
+		return c
+	}
+
+	for _, tt := range []struct {
+		name    string
+		chain   *fakechain.Chain
+		node    *cosmosconfig.Node
+		wantErr []string
+	}{
+		{"valid", funded(fakechain.Config{Bech32Prefix: "wasm"}), primary, nil},
+		{"denom metadata", fakechain.New(fakechain.Config{Bech32Prefix: "wasm", DenomMetadata: []banktypes.Metadata{{Base: "ucosm"}}}), primary, nil},
+		{"minimum gas price", funded(fakechain.Config{Bech32Prefix: "wasm", MinGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("ucosm", sdk.MustNewDecFromStr("0.01")))}), primary, nil},
+		{"chain ID", fakechain.New(fakechain.Config{ChainID: "other-1"}), primary, []string{"node serves chain other-1 instead of fakechain-1"}},
+		{"send-only", fakechain.New(fakechain.Config{Bech32Prefix: "cosmos"}), sender, nil},
+		{"bech32 prefix", funded(fakechain.Config{Bech32Prefix: "cosmos"}), primary, []string{"Bech32Prefix mismatch"}},
+		{"unknown gas token", fakechain.New(fakechain.Config{Bech32Prefix: "wasm"}), primary, []string{"unknown gas token ucosm"}},
+		{"fee denom", funded(fakechain.Config{Bech32Prefix: "wasm", MinGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.MustNewDecFromStr("0.01")))}), primary,
+			[]string{"none of the fee denoms [ucosm]"}},
+		{"fallback gas price", funded(fakechain.Config{Bech32Prefix: "wasm", MinGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("ucosm", sdk.MustNewDecFromStr("0.02")))}), primary,
+			[]string{"FallbackGasPrice 0.015000000000000000 is below the minimum gas price 0.020000000000000000ucosm"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkNode(tt.chain, tt.node, chainID, cfg)
+			if len(tt.wantErr) == 0 {
+				require.NoError(t, err)
+				assert.Equal(t, NodeStateAlive, nodeStateOf(err))
+				return
+			}
+			for _, want := range tt.wantErr {
+				assert.ErrorContains(t, err, want)
+			}
+			assert.Equal(t, NodeStateInvalid, nodeStateOf(err))
+		})
+	}
+
+	t.Run("unreachable", func(t *testing.T) {
+		c := fakechain.New(fakechain.Config{})
+		c.FailCalls("Status", client.ErrTransient)
+		err := checkNode(c, primary, chainID, cfg)
+		assert.ErrorIs(t, err, client.ErrTransient)
+		assert.Equal(t, NodeStateUnreachable, nodeStateOf(err))
+	})
+}
+
+func TestNodeChecks(t *testing.T) {
+	var cs nodeChecks
+	node := testNode("node", cosmosconfig.NodeRolePrimary, 0)
+	assert.Equal(t, NodeStateUnknown, cs.state(node))
+
+	cs.set(node, nil)
+	assert.Equal(t, NodeStateAlive, cs.state(node))
+
+	// results are tied to the node config
+	updated := testNode("node", cosmosconfig.NodeRolePrimary, 1)
+	_, ok := cs.get(updated)
+	assert.False(t, ok)
+
+	cs.set(updated, errNodeUnreachable)
+	assert.Equal(t, "Unreachable: node unreachable", cs.state(updated))
+
+	// only nodes which were unreachable with their current config are checked again
+	invalid := testNode("invalid", cosmosconfig.NodeRolePrimary, 0)
+	cs.set(invalid, errors.New("Bech32Prefix mismatch"))
+	pending := testNode("pending", cosmosconfig.NodeRolePrimary, 0)
+	assert.Equal(t, cosmosconfig.Nodes{updated}, cs.unreachable(cosmosconfig.Nodes{node, updated, invalid, pending}))
+	assert.Empty(t, cs.unreachable(cosmosconfig.Nodes{testNode("node", cosmosconfig.NodeRolePrimary, 2)}))
+}
@@ -8,13 +8,16 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/adapters"
-	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/adapters/cosmwasm"
-	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/adapters/injective"
+	// register the chain families
+	_ "github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/adapters/cosmwasm"
+	_ "github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/adapters/injective"
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/txm"
 )
 
 const (
+	// InjectivePrefix is the Bech32Prefix of Injective.
+	// Deprecated: adapters are selected by config.ChainFamily.
 	InjectivePrefix string = "inj"
 )
 
@@ -83,26 +86,12 @@ func (r *Relayer) NewFunctionsProvider(rargs types.RelayArgs, pargs types.Plugin
 	return nil, errors.New("functions are not supported for cosmos")
 }
 
+// NewConfigProvider returns a ConfigProvider from the adapters of the ChainFamily of the chain.
 func (r *Relayer) NewConfigProvider(args types.RelayArgs) (types.ConfigProvider, error) {
-	var configProvider types.ConfigProvider
-	var err error
-	if r.chain.Config().Bech32Prefix() == InjectivePrefix {
-		configProvider, err = injective.NewConfigProvider(r.ctx, r.lggr, r.chain, args)
-	} else {
-		// Default to cosmwasm adapter
-		configProvider, err = cosmwasm.NewConfigProvider(r.ctx, r.lggr, r.chain, args)
-	}
-	if err != nil {
-		return nil, err
-	}
-
-	return configProvider, err
+	return adapters.NewConfigProvider(r.ctx, r.lggr, r.chain, args)
 }
 
+// NewMedianProvider returns a MedianProvider from the adapters of the ChainFamily of the chain.
 func (r *Relayer) NewMedianProvider(rargs types.RelayArgs, pargs types.PluginArgs) (types.MedianProvider, error) {
-	configProvider, err := cosmwasm.NewMedianProvider(r.ctx, r.lggr, r.chain, rargs, pargs)
-	if err != nil {
-		return nil, err
-	}
-	return configProvider, err
+	return adapters.NewMedianProvider(r.ctx, r.lggr, r.chain, rargs, pargs)
 }
@@ -0,0 +1,537 @@
+// Package fakechain provides a deterministic in-memory chain implementing client.ReaderWriter,
+// for testing the txm, contract readers and transmitters end to end without a node.
+package fakechain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/p2p"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	cosmosclient "github.com/cosmos/cosmos-sdk/client"
+	tmtypes "github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/client"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
+)
+
+// Config configures a Chain. Zero values are replaced with the defaults below.
+type Config struct {
+	ChainID string
+	// BaseGas and GasPerMsg determine the gas used by a tx: BaseGas + GasPerMsg*len(msgs).
+	BaseGas   uint64
+	GasPerMsg uint64
+	// MinGasPrices are the minimum gas prices accepted by the mempool. Empty means any fee is accepted.
+	MinGasPrices sdk.DecCoins
+	// MempoolSize is the maximum number of txs in the mempool.
+	MempoolSize int
+	// MaxBlockTxs is the maximum number of txs included in a block.
+	MaxBlockTxs int
+	// BlockTime is the time between blocks, starting at GenesisTime.
+	BlockTime   time.Duration
+	GenesisTime time.Time
+	// VerifySignatures enables signature verification of broadcast txs.
+	VerifySignatures bool
+	// Bech32Prefix encodes the addresses returned by AddressString. Empty means that of the global sdk.Config.
+	Bech32Prefix string
+	// DenomMetadata is the metadata registered in the bank module.
+	DenomMetadata []banktypes.Metadata
+}
+
+// Defaults for zero Config fields.
+const (
+	DefaultChainID     = "fakechain-1"
+	DefaultBaseGas     = 50_000
+	DefaultGasPerMsg   = 100_000
+	DefaultMempoolSize = 5000
+	DefaultMaxBlockTxs = 100
+	DefaultBlockTime   = 5 * time.Second
+)
+
+// DefaultGenesisTime is the time of the first block, unless configured otherwise.
+var DefaultGenesisTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func (c *Config) setDefaults() {
+	if c.ChainID == "" {
+		c.ChainID = DefaultChainID
+	}
+	if c.BaseGas == 0 {
+		c.BaseGas = DefaultBaseGas
+	}
+	if c.GasPerMsg == 0 {
+		c.GasPerMsg = DefaultGasPerMsg
+	}
+	if c.MempoolSize == 0 {
+		c.MempoolSize = DefaultMempoolSize
+	}
+	if c.MaxBlockTxs == 0 {
+		c.MaxBlockTxs = DefaultMaxBlockTxs
+	}
+	if c.BlockTime == 0 {
+		c.BlockTime = DefaultBlockTime
+	}
+	if c.GenesisTime.IsZero() {
+		c.GenesisTime = DefaultGenesisTime
+	}
+}
+
+type account struct {
+	number   uint64
+	sequence uint64
+	balance  sdk.Coins
+	pubKey   cryptotypes.PubKey
+}
+
+type block struct {
+	header  cmttypes.Header
+	txs     cmttypes.Txs
+	results []*sdk.TxResponse
+}
+
+// Chain is a deterministic in-memory chain implementing client.ReaderWriter.
+// Blocks are only produced by NextBlock, or periodically after AutoProduce.
+// Chain is safe for concurrent use.
+type Chain struct {
+	cfg Config
+
+	mu          sync.Mutex
+	blocks      []*block // blocks[h-1] is the block at height h
+	accounts    map[string]*account
+	history     map[int64]map[string]account // account state as of each height
+	nextAccount uint64
+	contracts   map[string]Contract
+	mempool     []*mempoolTx
+	txs         map[string]*sdk.TxResponse // by hash
+	failMsg     func(sdk.Msg) error
+	failCalls   map[string][]error
+
+	headerSubs []chan cmttypes.EventDataNewBlockHeader
+	txSubs     []*txSub
+}
+
+type txSub struct {
+	query *cmtquery.Query
+	ch    chan cmttypes.EventDataTx
+}
+
+var _ client.ReaderWriter = (*Chain)(nil)
+
+// registerOnce registers the msgs executed by the chain, so that txs can be decoded.
+var registerOnce sync.Once
+
+// New returns a Chain at height 1, with no accounts.
+func New(cfg Config) *Chain {
+	registerOnce.Do(func() {
+		registry := params.NewClientContext().InterfaceRegistry
+		banktypes.RegisterInterfaces(registry)
+		wasmtypes.RegisterInterfaces(registry)
+	})
+	cfg.setDefaults()
+	c := &Chain{
+		cfg:       cfg,
+		accounts:  make(map[string]*account),
+		history:   make(map[int64]map[string]account),
+		contracts: make(map[string]Contract),
+		txs:       make(map[string]*sdk.TxResponse),
+		failCalls: make(map[string][]error),
+	}
+	c.commit(nil, nil)
+	return c
+}
+
+// ChainID returns the chain id.
+func (c *Chain) ChainID() string { return c.cfg.ChainID }
+
+// Height returns the height of the latest block.
+func (c *Chain) Height() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.height()
+}
+
+func (c *Chain) height() int64 { return int64(len(c.blocks)) }
+
+// Fund adds coins to the balance of addr, creating its account if it does not exist yet.
+func (c *Chain) Fund(addr sdk.AccAddress, coins ...sdk.Coin) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	acc := c.account(addr.String())
+	acc.balance = acc.balance.Add(coins...)
+	c.snapshot(c.height())
+}
+
+// account returns the account of addr, creating it if necessary. c.mu must be held.
+func (c *Chain) account(addr string) *account {
+	acc, ok := c.accounts[addr]
+	if !ok {
+		acc = &account{number: c.nextAccount}
+		c.nextAccount++
+		c.accounts[addr] = acc
+	}
+	return acc
+}
+
+// snapshot records the account state as of height. c.mu must be held.
+func (c *Chain) snapshot(height int64) {
+	accounts := make(map[string]account, len(c.accounts))
+	for addr, acc := range c.accounts {
+		accounts[addr] = *acc
+	}
+	c.history[height] = accounts
+}
+
+// FailMsgs makes the execution and simulation of msgs fail with the error returned by fail, if any.
+// Errors registered with cosmossdk.io/errors keep their ABCI codespace and code. Pass nil to stop failing msgs.
+func (c *Chain) FailMsgs(fail func(sdk.Msg) error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.failMsg = fail
+}
+
+// FailCalls makes the next len(errs) calls of the client.ReaderWriter method with the given name return errs, in order,
+// e.g. FailCalls("Broadcast", client.ErrTransient).
+func (c *Chain) FailCalls(method string, errs ...error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.failCalls[method] = append(c.failCalls[method], errs...)
+}
+
+// failCall returns the next injected error for method, if any. c.mu must be held.
+func (c *Chain) failCall(method string) error {
+	errs := c.failCalls[method]
+	if len(errs) == 0 {
+		return nil
+	}
+	c.failCalls[method] = errs[1:]
+	return errs[0]
+}
+
+// NextBlock produces a block with the txs at the front of the mempool, and returns its height.
+func (c *Chain) NextBlock() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	n := min(len(c.mempool), c.cfg.MaxBlockTxs)
+	included := c.mempool[:n:n]
+	c.mempool = c.mempool[n:]
+	height := c.height() + 1
+	results := make([]*sdk.TxResponse, len(included))
+	for i, tx := range included {
+		results[i] = c.deliverTx(tx, height)
+	}
+	c.commit(included, results)
+	c.recheck()
+	return height
+}
+
+// NextBlocks produces n blocks, and returns the height of the last one.
+func (c *Chain) NextBlocks(n int) (height int64) {
+	for i := 0; i < n; i++ {
+		height = c.NextBlock()
+	}
+	return
+}
+
+// AutoProduce produces a block every period, until stop is called.
+func (c *Chain) AutoProduce(period time.Duration) (stop func()) {
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(period)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				c.NextBlock()
+			}
+		}
+	}()
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(done) })
+		wg.Wait()
+	}
+}
+
+// commit appends a block with txs and their results, and notifies subscribers. c.mu must be held.
+func (c *Chain) commit(txs []*mempoolTx, results []*sdk.TxResponse) {
+	height := c.height() + 1
+	b := &block{
+		header: cmttypes.Header{
+			ChainID: c.cfg.ChainID,
+			Height:  height,
+			Time:    c.cfg.GenesisTime.Add(time.Duration(height-1) * c.cfg.BlockTime),
+			// the header hash is only computed with a validators hash
+			ValidatorsHash: tmhash.Sum([]byte(c.cfg.ChainID)),
+		},
+		results: results,
+	}
+	if len(c.blocks) > 0 {
+		prev := c.blocks[len(c.blocks)-1].header
+		b.header.LastBlockID = cmttypes.BlockID{Hash: prev.Hash()}
+	}
+	for _, tx := range txs {
+		b.txs = append(b.txs, tx.bytes)
+	}
+	b.header.DataHash = b.txs.Hash()
+	timestamp := b.header.Time.Format(time.RFC3339)
+	for _, r := range results {
+		r.Timestamp = timestamp
+		c.txs[r.TxHash] = r
+	}
+	c.blocks = append(c.blocks, b)
+	c.snapshot(height)
+
+	for _, ch := range c.headerSubs {
+		select {
+		case ch <- cmttypes.EventDataNewBlockHeader{Header: b.header}:
+		default: // slow subscribers miss events, like with a node
+		}
+	}
+	for i, r := range results {
+		event := cmttypes.EventDataTx{TxResult: txResult(r, b.txs[i], uint32(i))}
+		attrs := txEventAttributes(r)
+		for _, sub := range c.txSubs {
+			if ok, err := sub.query.Matches(attrs); err != nil || !ok {
+				continue
+			}
+			select {
+			case sub.ch <- event:
+			default:
+			}
+		}
+	}
+}
+
+// block returns the block at height, or the latest block if height is 0. c.mu must be held.
+func (c *Chain) block(height int64) (*block, error) {
+	if height == 0 {
+		height = c.height()
+	}
+	if height < 1 || height > c.height() {
+		return nil, status.Errorf(codes.InvalidArgument, "requested block height %d is not available, latest height is %d", height, c.height())
+	}
+	return c.blocks[height-1], nil
+}
+
+func (c *Chain) Account(address sdk.AccAddress) (uint64, uint64, error) {
+	return c.AccountAt(address, 0)
+}
+
+func (c *Chain) AccountAt(address sdk.AccAddress, height int64) (uint64, uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.failCall("Account"); err != nil {
+		return 0, 0, err
+	}
+	if height == 0 {
+		height = c.height()
+	}
+	accounts, ok := c.history[height]
+	if !ok {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "no state at height %d", height)
+	}
+	acc, ok := accounts[address.String()]
+	if !ok {
+		return 0, 0, client.ClassifyError(status.Errorf(codes.NotFound, "account %s not found", address))
+	}
+	return acc.number, acc.sequence, nil
+}
+
+func (c *Chain) Balance(addr sdk.AccAddress, denom string) (*sdk.Coin, error) {
+	return c.BalanceAt(addr, denom, 0)
+}
+
+func (c *Chain) BalanceAt(addr sdk.AccAddress, denom string, height int64) (*sdk.Coin, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.failCall("Balance"); err != nil {
+		return nil, err
+	}
+	if height == 0 {
+		height = c.height()
+	}
+	accounts, ok := c.history[height]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "no state at height %d", height)
+	}
+	coin := sdk.NewCoin(denom, accounts[addr.String()].balance.AmountOf(denom))
+	return &coin, nil
+}
+
+func (c *Chain) LatestBlock() (*tmtypes.GetLatestBlockResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.failCall("LatestBlock"); err != nil {
+		return nil, err
+	}
+	b, _ := c.block(0)
+	id, pb, sdkBlock := b.proto()
+	return &tmtypes.GetLatestBlockResponse{BlockId: id, Block: pb, SdkBlock: sdkBlock}, nil
+}
+
+func (c *Chain) BlockByHeight(height int64) (*tmtypes.GetBlockByHeightResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.failCall("BlockByHeight"); err != nil {
+		return nil, err
+	}
+	b, err := c.block(height)
+	if err != nil {
+		return nil, err
+	}
+	id, pb, sdkBlock := b.proto()
+	return &tmtypes.GetBlockByHeightResponse{BlockId: id, Block: pb, SdkBlock: sdkBlock}, nil
+}
+
+func (c *Chain) Status() (*coretypes.ResultStatus, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.failCall("Status"); err != nil {
+		return nil, err
+	}
+	b, _ := c.block(0)
+	return &coretypes.ResultStatus{
+		NodeInfo: p2p.DefaultNodeInfo{Network: c.cfg.ChainID},
+		SyncInfo: coretypes.SyncInfo{
+			LatestBlockHash:     b.header.Hash(),
+			LatestBlockHeight:   b.header.Height,
+			LatestBlockTime:     b.header.Time,
+			EarliestBlockHeight: 1,
+			EarliestBlockTime:   c.cfg.GenesisTime,
+		},
+	}, nil
+}
+
+func (c *Chain) Context() *cosmosclient.Context {
+	ctx := params.NewClientContext().WithChainID(c.cfg.ChainID)
+	return &ctx
+}
+
+func (c *Chain) SubscribeNewBlockHeaders(ctx context.Context) (<-chan cmttypes.EventDataNewBlockHeader, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.failCall("SubscribeNewBlockHeaders"); err != nil {
+		return nil, err
+	}
+	ch := make(chan cmttypes.EventDataNewBlockHeader, subscriptionBufferSize)
+	c.headerSubs = append(c.headerSubs, ch)
+	go func() {
+		<-ctx.Done()
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		for i, sub := range c.headerSubs {
+			if sub == ch {
+				c.headerSubs = append(c.headerSubs[:i], c.headerSubs[i+1:]...)
+				break
+			}
+		}
+		close(ch)
+	}()
+	return ch, nil
+}
+
+func (c *Chain) SubscribeTxs(ctx context.Context, query string) (<-chan cmttypes.EventDataTx, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.failCall("SubscribeTxs"); err != nil {
+		return nil, err
+	}
+	q, err := cmtquery.New(fmt.Sprintf("%s AND %s", cmttypes.EventQueryTx, query))
+	if err != nil {
+		return nil, fmt.Errorf("invalid query %q: %w", query, err)
+	}
+	sub := &txSub{query: q, ch: make(chan cmttypes.EventDataTx, subscriptionBufferSize)}
+	c.txSubs = append(c.txSubs, sub)
+	go func() {
+		<-ctx.Done()
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		for i, s := range c.txSubs {
+			if s == sub {
+				c.txSubs = append(c.txSubs[:i], c.txSubs[i+1:]...)
+				break
+			}
+		}
+		close(sub.ch)
+	}()
+	return sub.ch, nil
+}
+
+func (c *Chain) SubscribeTx(ctx context.Context, txHash string) (<-chan cmttypes.EventDataTx, error) {
+	return c.SubscribeTxs(ctx, fmt.Sprintf("%s='%s'", cmttypes.TxHashKey, strings.ToUpper(txHash)))
+}
+
+// subscriptionBufferSize matches the buffer of client subscriptions.
+const subscriptionBufferSize = 16
+
+// FeeMarketGasPrice fails, since the chain has no x/feemarket module.
+func (c *Chain) FeeMarketGasPrice(denom string) (sdk.DecCoin, error) {
+	return sdk.DecCoin{}, status.Error(codes.Unimplemented, "unknown query path")
+}
+
+// EIP1559BaseFee fails, since the chain has no x/txfees module.
+func (c *Chain) EIP1559BaseFee() (sdk.Dec, error) {
+	return sdk.Dec{}, status.Error(codes.Unimplemented, "unknown query path")
+}
+
+func (c *Chain) MinimumGasPrices() (sdk.DecCoins, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.failCall("MinimumGasPrices"); err != nil {
+		return nil, err
+	}
+	return c.cfg.MinGasPrices, nil
+}
+
+func (c *Chain) AddressString(addr sdk.AccAddress) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.failCall("AddressString"); err != nil {
+		return "", err
+	}
+	return params.Bech32Prefix(c.cfg.Bech32Prefix).Address(addr), nil
+}
+
+func (c *Chain) DenomMetadata(denom string) (*banktypes.Metadata, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.failCall("DenomMetadata"); err != nil {
+		return nil, err
+	}
+	for _, m := range c.cfg.DenomMetadata {
+		if m.Base == denom {
+			return &m, nil
+		}
+	}
+	return nil, client.ClassifyError(status.Errorf(codes.NotFound, "client metadata for denom %s", denom))
+}
+
+// SupplyOf returns the sum of the balances of denom, since the chain has no other holders.
+func (c *Chain) SupplyOf(denom string) (*sdk.Coin, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.failCall("SupplyOf"); err != nil {
+		return nil, err
+	}
+	supply := sdk.NewCoin(denom, sdk.ZeroInt())
+	for _, a := range c.accounts {
+		supply.Amount = supply.Amount.Add(a.balance.AmountOf(denom))
+	}
+	return &supply, nil
+}
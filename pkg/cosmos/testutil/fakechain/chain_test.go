@@ -0,0 +1,264 @@
+package fakechain
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/client"
+)
+
+var gasPrice = sdk.NewDecCoinFromDec("ucosm", sdk.MustNewDecFromStr("0.01"))
+
+// counter deploys a contract counting executions, which fails to execute "fail" msgs.
+func counter(c *Chain) sdk.AccAddress {
+	var n int
+	return c.Deploy(&FuncContract{
+		QueryFunc: func(msg []byte) ([]byte, error) {
+			return []byte(strconv.Itoa(n)), nil
+		},
+		ExecuteFunc: func(sender string, msg []byte, simulate bool) ([]abci.Event, error) {
+			if string(msg) == `"fail"` {
+				return nil, errors.New("failed on purpose")
+			}
+			if !simulate {
+				n++
+			}
+			return []abci.Event{WasmEvent("wasm", "count", strconv.Itoa(n))}, nil
+		},
+	})
+}
+
+type testAccount struct {
+	key  cryptotypes.PrivKey
+	addr sdk.AccAddress
+}
+
+func newAccount(c *Chain) testAccount {
+	key := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(key.PubKey().Address())
+	c.Fund(addr, sdk.NewInt64Coin("ucosm", 1_000_000_000))
+	return testAccount{key: key, addr: addr}
+}
+
+func execute(sender testAccount, contract sdk.AccAddress, msg string) sdk.Msg {
+	return &wasmtypes.MsgExecuteContract{Sender: sender.addr.String(), Contract: contract.String(), Msg: []byte(msg)}
+}
+
+func sign(t *testing.T, c *Chain, from testAccount, sequence uint64, timeoutHeight uint64, msgs ...sdk.Msg) []byte {
+	number, _, err := c.Account(from.addr)
+	require.NoError(t, err)
+	txBytes, err := c.CreateAndSign(msgs, number, sequence, 1_000_000, 1, gasPrice, from.key, timeoutHeight)
+	require.NoError(t, err)
+	return txBytes
+}
+
+func TestChain_Accounts(t *testing.T) {
+	c := New(Config{})
+	alice := newAccount(c)
+	number, sequence, err := c.Account(alice.addr)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), number)
+	assert.Equal(t, uint64(0), sequence)
+
+	_, _, err = c.Account(sdk.AccAddress("unknown_____________"))
+	require.ErrorIs(t, err, client.ErrNotFound)
+
+	c.FailCalls("Account", client.ErrTransient)
+	_, _, err = c.Account(alice.addr)
+	require.ErrorIs(t, err, client.ErrTransient)
+	_, _, err = c.Account(alice.addr)
+	require.NoError(t, err)
+}
+
+func TestChain_Txs(t *testing.T) {
+	c := New(Config{VerifySignatures: true})
+	alice := newAccount(c)
+	contract := counter(c)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// txs are checked against the pending sequence
+	tx0 := sign(t, c, alice, 0, 0, execute(alice, contract, `"inc"`))
+	res, err := c.Broadcast(tx0, txtypes.BroadcastMode_BROADCAST_MODE_SYNC)
+	require.NoError(t, err)
+	included, err := c.SubscribeTx(ctx, res.TxResponse.TxHash)
+	require.NoError(t, err)
+	_, err = c.Broadcast(sign(t, c, alice, 0, 0, execute(alice, contract, `"other"`)), txtypes.BroadcastMode_BROADCAST_MODE_SYNC)
+	require.ErrorIs(t, err, client.ErrSequenceMismatch)
+	_, err = c.Broadcast(sign(t, c, alice, 1, 0, execute(alice, contract, `"inc"`), execute(alice, contract, `"fail"`)), txtypes.BroadcastMode_BROADCAST_MODE_SYNC)
+	require.NoError(t, err)
+
+	_, err = c.Tx(res.TxResponse.TxHash)
+	require.ErrorIs(t, err, client.ErrNotFound)
+
+	height := c.NextBlock()
+	select {
+	case event := <-included:
+		assert.Equal(t, height, event.Height)
+	case <-time.After(time.Second):
+		t.Fatal("tx event not received")
+	}
+	_, sequence, err := c.Account(alice.addr)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), sequence)
+
+	tx, err := c.Tx(res.TxResponse.TxHash)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0), tx.TxResponse.Code)
+	assert.Equal(t, height, tx.TxResponse.Height)
+
+	// failing msgs revert the whole tx, but the fee is still paid
+	txs, err := c.TxsEventsPage([]string{"tx.height=" + strconv.FormatInt(height, 10)}, txtypes.OrderBy_ORDER_BY_DESC, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, txs.TxResponses, 2)
+	failed := txs.TxResponses[0]
+	assert.Equal(t, wasmtypes.ErrExecuteFailed.ABCICode(), failed.Code)
+	assert.Contains(t, failed.RawLog, "message index: 1")
+	count, err := c.ContractState(contract, []byte(`"count"`))
+	require.NoError(t, err)
+	assert.Equal(t, "1", string(count))
+	before, err := c.BalanceAt(alice.addr, "ucosm", height-1)
+	require.NoError(t, err)
+	after, err := c.Balance(alice.addr, "ucosm")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2*10_000), before.Amount.Sub(after.Amount).Int64())
+
+	// contract events are tagged with the contract address
+	txs, err = c.TxsEvents([]string{"wasm._contract_address='" + contract.String() + "'"}, nil)
+	require.NoError(t, err)
+	require.Len(t, txs.TxResponses, 1)
+	assert.Equal(t, res.TxResponse.TxHash, txs.TxResponses[0].TxHash)
+
+	// signatures are verified against the chain id
+	txBytes, err := client.SignTx("other-chain", []sdk.Msg{execute(alice, contract, `"inc"`)}, 0, 2, 1_000_000, 1, gasPrice, alice.key, 0)
+	require.NoError(t, err)
+	res, err = c.Broadcast(txBytes, txtypes.BroadcastMode_BROADCAST_MODE_SYNC)
+	require.Error(t, err)
+	assert.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), res.TxResponse.Code)
+}
+
+func TestChain_Simulate(t *testing.T) {
+	c := New(Config{BaseGas: 10, GasPerMsg: 100})
+	alice := newAccount(c)
+	contract := counter(c)
+
+	sim, err := c.SimulateUnsigned([]sdk.Msg{execute(alice, contract, `"inc"`), execute(alice, contract, `"inc"`)}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(210), sim.GasInfo.GasUsed)
+
+	c.FailMsgs(func(msg sdk.Msg) error {
+		if string(msg.(*wasmtypes.MsgExecuteContract).Msg) == `"injected"` {
+			return sdkerrors.ErrInvalidRequest
+		}
+		return nil
+	})
+	msgs := client.SimMsgs{
+		{ID: 1, Msg: execute(alice, contract, `"inc"`)},
+		{ID: 2, Msg: execute(alice, contract, `"fail"`)},
+		{ID: 3, Msg: execute(alice, contract, `"inc"`)},
+		{ID: 4, Msg: execute(alice, contract, `"injected"`)},
+	}
+	_, err = c.SimulateUnsigned(msgs.GetMsgs(), 0)
+	index, ok := client.FailedMsgIndex(err)
+	require.True(t, ok)
+	assert.Equal(t, 1, index)
+	results, err := c.BatchSimulateUnsigned(msgs, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 3}, results.Succeeded.GetSimMsgsIDs())
+	assert.Equal(t, []int64{2, 4}, results.Failed.GetSimMsgsIDs())
+
+	_, err = c.SimulateUnsigned([]sdk.Msg{execute(alice, contract, `"inc"`)}, 1)
+	require.ErrorContains(t, err, "account sequence mismatch")
+
+	// simulations leave no state behind
+	count, err := c.ContractState(contract, []byte(`"count"`))
+	require.NoError(t, err)
+	assert.Equal(t, "0", string(count))
+}
+
+func TestChain_Mempool(t *testing.T) {
+	t.Run("fees", func(t *testing.T) {
+		c := New(Config{MinGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("ucosm", sdk.MustNewDecFromStr("0.02")))})
+		alice := newAccount(c)
+		_, err := c.Broadcast(sign(t, c, alice, 0, 0, execute(alice, counter(c), `"inc"`)), txtypes.BroadcastMode_BROADCAST_MODE_SYNC)
+		require.ErrorIs(t, err, client.ErrInsufficientFee)
+	})
+
+	t.Run("full", func(t *testing.T) {
+		c := New(Config{MempoolSize: 1, MaxBlockTxs: 1})
+		alice, bob := newAccount(c), newAccount(c)
+		contract := counter(c)
+		_, err := c.Broadcast(sign(t, c, alice, 0, 0, execute(alice, contract, `"inc"`)), txtypes.BroadcastMode_BROADCAST_MODE_SYNC)
+		require.NoError(t, err)
+		_, err = c.Broadcast(sign(t, c, bob, 0, 0, execute(bob, contract, `"inc"`)), txtypes.BroadcastMode_BROADCAST_MODE_SYNC)
+		require.ErrorIs(t, err, client.ErrMempoolFull)
+		c.NextBlock()
+		_, err = c.Broadcast(sign(t, c, bob, 0, 0, execute(bob, contract, `"inc"`)), txtypes.BroadcastMode_BROADCAST_MODE_SYNC)
+		require.NoError(t, err)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		c := New(Config{MaxBlockTxs: 1})
+		alice, bob := newAccount(c), newAccount(c)
+		contract := counter(c)
+		_, err := c.Broadcast(sign(t, c, alice, 0, 0, execute(alice, contract, `"inc"`)), txtypes.BroadcastMode_BROADCAST_MODE_SYNC)
+		require.NoError(t, err)
+		// bob's tx times out while waiting behind alice's
+		timeout := uint64(c.Height())
+		res, err := c.Broadcast(sign(t, c, bob, 0, timeout, execute(bob, contract, `"inc"`)), txtypes.BroadcastMode_BROADCAST_MODE_SYNC)
+		require.NoError(t, err)
+		c.NextBlocks(2)
+		_, err = c.Tx(res.TxResponse.TxHash)
+		require.ErrorIs(t, err, client.ErrNotFound)
+		_, sequence, err := c.Account(bob.addr)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(0), sequence)
+
+		_, err = c.Broadcast(sign(t, c, bob, 0, timeout, execute(bob, contract, `"inc"`)), txtypes.BroadcastMode_BROADCAST_MODE_SYNC)
+		require.Error(t, err)
+	})
+}
+
+func TestChain_Blocks(t *testing.T) {
+	c := New(Config{BlockTime: time.Second})
+	ctx, cancel := context.WithCancel(context.Background())
+	headers, err := c.SubscribeNewBlockHeaders(ctx)
+	require.NoError(t, err)
+
+	stop := c.AutoProduce(time.Millisecond)
+	for i := int64(2); i <= 4; i++ {
+		header := <-headers
+		assert.Equal(t, i, header.Header.Height)
+		assert.Equal(t, DefaultGenesisTime.Add(time.Duration(i-1)*time.Second), header.Header.Time)
+	}
+	stop()
+	cancel()
+	for range headers {
+		// drain until closed
+	}
+
+	latest, err := c.LatestBlock()
+	require.NoError(t, err)
+	assert.Equal(t, c.Height(), latest.SdkBlock.Header.Height)
+	prev, err := c.BlockByHeight(c.Height() - 1)
+	require.NoError(t, err)
+	assert.Equal(t, prev.BlockId.Hash, latest.SdkBlock.Header.LastBlockId.Hash)
+	status, err := c.Status()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultChainID, status.NodeInfo.Network)
+	assert.Equal(t, c.Height(), status.SyncInfo.LatestBlockHeight)
+}
@@ -0,0 +1,180 @@
+package fakechain
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/client"
+)
+
+// attrContractAddress is added by wasmd to every event emitted by a contract.
+const attrContractAddress = "_contract_address"
+
+// Contract is a CosmWasm contract stub.
+// Its methods are called with the chain locked, so they must not call the Chain.
+type Contract interface {
+	// Query answers the smart query msg.
+	Query(msg []byte) ([]byte, error)
+	// Execute executes msg sent by sender, and returns the events it emitted.
+	// A msg is always simulated before it is executed, and Execute must not modify the contract state while simulating.
+	Execute(sender string, msg []byte, simulate bool) ([]abci.Event, error)
+}
+
+// RawContract is a Contract which also serves raw reads of its storage.
+type RawContract interface {
+	Contract
+	// Raw returns the value stored at key, or nil if there is none.
+	Raw(key []byte) ([]byte, error)
+}
+
+// FuncContract is a RawContract implemented by funcs. Queries and executions fail if their func is nil.
+type FuncContract struct {
+	QueryFunc   func(msg []byte) ([]byte, error)
+	ExecuteFunc func(sender string, msg []byte, simulate bool) ([]abci.Event, error)
+	RawFunc     func(key []byte) ([]byte, error)
+}
+
+var _ RawContract = (*FuncContract)(nil)
+
+var errUnknownRequest = errors.New("unknown request")
+
+func (f *FuncContract) Query(msg []byte) ([]byte, error) {
+	if f.QueryFunc == nil {
+		return nil, errUnknownRequest
+	}
+	return f.QueryFunc(msg)
+}
+
+func (f *FuncContract) Execute(sender string, msg []byte, simulate bool) ([]abci.Event, error) {
+	if f.ExecuteFunc == nil {
+		return nil, errUnknownRequest
+	}
+	return f.ExecuteFunc(sender, msg, simulate)
+}
+
+func (f *FuncContract) Raw(key []byte) ([]byte, error) {
+	if f.RawFunc == nil {
+		return nil, nil
+	}
+	return f.RawFunc(key)
+}
+
+// Deploy instantiates contract, and returns its address.
+// Addresses are derived like wasmd classic addresses, from code id 1 and the instance number.
+func (c *Chain) Deploy(contract Contract) sdk.AccAddress {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	contractID := make([]byte, 16)
+	binary.BigEndian.PutUint64(contractID[:8], 1)
+	binary.BigEndian.PutUint64(contractID[8:], uint64(len(c.contracts)+1))
+	addr := sdk.AccAddress(address.Module(wasmtypes.ModuleName, contractID)[:wasmtypes.ContractAddrLen])
+	c.contracts[addr.String()] = contract
+	return addr
+}
+
+func (c *Chain) ContractState(contractAddress sdk.AccAddress, queryMsg []byte) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.failCall("ContractState"); err != nil {
+		return nil, err
+	}
+	return c.contractState(contractAddress, queryMsg)
+}
+
+// ContractStateAt only supports the latest height, since contract stubs keep no history.
+func (c *Chain) ContractStateAt(contractAddress sdk.AccAddress, queryMsg []byte, height int64) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.failCall("ContractStateAt"); err != nil {
+		return nil, err
+	}
+	if height != c.height() {
+		return nil, status.Errorf(codes.InvalidArgument, "contract state is only available at the latest height %d, not %d", c.height(), height)
+	}
+	return c.contractState(contractAddress, queryMsg)
+}
+
+func (c *Chain) BatchContractState(queries []client.ContractQuery) ([]client.ContractQueryResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.failCall("BatchContractState"); err != nil {
+		return nil, err
+	}
+	results := make([]client.ContractQueryResult, len(queries))
+	for i, q := range queries {
+		results[i].Data, results[i].Err = c.contractState(q.ContractAddress, q.QueryMsg)
+	}
+	return results, nil
+}
+
+// contractState queries a contract. c.mu must be held.
+func (c *Chain) contractState(contractAddress sdk.AccAddress, queryMsg []byte) ([]byte, error) {
+	contract, ok := c.contracts[contractAddress.String()]
+	if !ok {
+		return nil, client.ClassifyError(status.Error(codes.NotFound, errorsmod.Wrapf(wasmtypes.ErrNotFound, "contract %s", contractAddress).Error()))
+	}
+	data, err := contract.Query(queryMsg)
+	if err != nil {
+		return nil, status.Error(codes.Unknown, errorsmod.Wrap(wasmtypes.ErrQueryFailed, err.Error()).Error())
+	}
+	return data, nil
+}
+
+func (c *Chain) RawContractState(contractAddress sdk.AccAddress, key []byte) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.failCall("RawContractState"); err != nil {
+		return nil, err
+	}
+	contract, ok := c.contracts[contractAddress.String()]
+	if !ok {
+		return nil, client.ClassifyError(status.Error(codes.NotFound, errorsmod.Wrapf(wasmtypes.ErrNotFound, "contract %s", contractAddress).Error()))
+	}
+	raw, ok := contract.(RawContract)
+	if !ok {
+		return nil, fmt.Errorf("contract %s does not support raw queries", contractAddress)
+	}
+	return raw.Raw(key)
+}
+
+// executeContract executes msg, and returns the events emitted, tagged with the contract address. c.mu must be held.
+func (c *Chain) executeContract(msg *wasmtypes.MsgExecuteContract, simulate bool) ([]abci.Event, error) {
+	contract, ok := c.contracts[msg.Contract]
+	if !ok {
+		return nil, errorsmod.Wrapf(wasmtypes.ErrNotFound, "contract %s", msg.Contract)
+	}
+	emitted, err := contract.Execute(msg.Sender, msg.Msg, simulate)
+	if err != nil {
+		return nil, errorsmod.Wrap(wasmtypes.ErrExecuteFailed, err.Error())
+	}
+	contractAttr := abci.EventAttribute{Key: attrContractAddress, Value: msg.Contract, Index: true}
+	events := []abci.Event{{Type: wasmtypes.EventTypeExecute, Attributes: []abci.EventAttribute{contractAttr}}}
+	for _, e := range emitted {
+		if e.Type != wasmtypes.WasmModuleEventType && !strings.HasPrefix(e.Type, wasmtypes.CustomContractEventPrefix) {
+			e.Type = wasmtypes.CustomContractEventPrefix + e.Type
+		}
+		e.Attributes = append([]abci.EventAttribute{contractAttr}, e.Attributes...)
+		events = append(events, e)
+	}
+	return events, nil
+}
+
+// WasmEvent returns an event of type typ, with attributes from alternating keys and values.
+// Types other than "wasm" are prefixed with "wasm-" on execution, like wasmd does with custom events.
+func WasmEvent(typ string, keyValues ...string) abci.Event {
+	e := abci.Event{Type: typ}
+	for i := 0; i+1 < len(keyValues); i += 2 {
+		e.Attributes = append(e.Attributes, abci.EventAttribute{Key: keyValues[i], Value: keyValues[i+1], Index: true})
+	}
+	return e
+}
@@ -0,0 +1,158 @@
+package fakechain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	tmtypes "github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/client"
+)
+
+// defaultPageLimit is the page size used by the node when none is given.
+const defaultPageLimit = 100
+
+func (c *Chain) Tx(hash string) (*txtypes.GetTxResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.failCall("Tx"); err != nil {
+		return nil, err
+	}
+	r, ok := c.txs[strings.ToUpper(hash)]
+	if !ok {
+		return nil, client.ClassifyError(status.Errorf(codes.NotFound, "tx not found: %s", hash))
+	}
+	return &txtypes.GetTxResponse{Tx: protoTx(r), TxResponse: r}, nil
+}
+
+func (c *Chain) TxsEvents(events []string, paginationParams *query.PageRequest) (*txtypes.GetTxsEventResponse, error) {
+	var offset, limit uint64
+	if paginationParams != nil {
+		offset, limit = paginationParams.Offset, paginationParams.Limit
+	}
+	return c.txsEvents("TxsEvents", events, txtypes.OrderBy_ORDER_BY_ASC, offset, limit)
+}
+
+func (c *Chain) TxsEventsPage(events []string, orderBy txtypes.OrderBy, page, limit uint64) (*txtypes.GetTxsEventResponse, error) {
+	if page == 0 {
+		page = 1
+	}
+	if limit == 0 {
+		limit = defaultPageLimit
+	}
+	return c.txsEvents("TxsEventsPage", events, orderBy, (page-1)*limit, limit)
+}
+
+func (c *Chain) txsEvents(method string, events []string, orderBy txtypes.OrderBy, offset, limit uint64) (*txtypes.GetTxsEventResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.failCall(method); err != nil {
+		return nil, err
+	}
+	if len(events) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "must declare at least one event to search")
+	}
+	q, err := cmtquery.New(strings.Join(events, " AND "))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid events %v: %v", events, err)
+	}
+	var matching []*sdk.TxResponse
+	for _, b := range c.blocks {
+		for _, r := range b.results {
+			if ok, err := q.Matches(txEventAttributes(r)); err == nil && ok {
+				matching = append(matching, r)
+			}
+		}
+	}
+	if orderBy == txtypes.OrderBy_ORDER_BY_DESC {
+		for i, j := 0, len(matching)-1; i < j; i, j = i+1, j-1 {
+			matching[i], matching[j] = matching[j], matching[i]
+		}
+	}
+	total := uint64(len(matching))
+	if limit == 0 {
+		limit = defaultPageLimit
+	}
+	start, end := min(offset, total), min(offset+limit, total)
+	resp := &txtypes.GetTxsEventResponse{
+		TxResponses: matching[start:end],
+		Pagination:  &query.PageResponse{Total: total},
+		Total:       total,
+	}
+	for _, r := range resp.TxResponses {
+		resp.Txs = append(resp.Txs, protoTx(r))
+	}
+	return resp, nil
+}
+
+// protoTx returns the tx of r.
+func protoTx(r *sdk.TxResponse) *txtypes.Tx {
+	if r.Tx == nil {
+		return nil
+	}
+	tx, _ := r.Tx.GetCachedValue().(*txtypes.Tx)
+	return tx
+}
+
+// txEventAttributes returns the composite event keys of r, and their values, for matching queries.
+func txEventAttributes(r *sdk.TxResponse) map[string][]string {
+	attrs := map[string][]string{
+		cmttypes.EventTypeKey: {cmttypes.EventTx},
+		cmttypes.TxHashKey:    {r.TxHash},
+		cmttypes.TxHeightKey:  {strconv.FormatInt(r.Height, 10)},
+	}
+	for _, e := range r.Events {
+		for _, attr := range e.Attributes {
+			key := fmt.Sprintf("%s.%s", e.Type, attr.Key)
+			attrs[key] = append(attrs[key], attr.Value)
+		}
+	}
+	return attrs
+}
+
+// txResult returns r as the result included in a block.
+func txResult(r *sdk.TxResponse, tx cmttypes.Tx, index uint32) abci.TxResult {
+	return abci.TxResult{
+		Height: r.Height,
+		Index:  index,
+		Tx:     tx,
+		Result: abci.ResponseDeliverTx{
+			Code:      r.Code,
+			Log:       r.RawLog,
+			GasWanted: r.GasWanted,
+			GasUsed:   r.GasUsed,
+			Events:    r.Events,
+			Codespace: r.Codespace,
+		},
+	}
+}
+
+// proto returns the block in the formats of the tendermint gRPC service.
+func (b *block) proto() (*cmtproto.BlockID, *cmtproto.Block, *tmtypes.Block) {
+	id := (&cmttypes.BlockID{Hash: b.header.Hash()}).ToProto()
+	data := cmtproto.Data{}
+	for _, tx := range b.txs {
+		data.Txs = append(data.Txs, tx)
+	}
+	h := b.header.ToProto()
+	sdkHeader := tmtypes.Header{
+		Version:        h.Version,
+		ChainID:        h.ChainID,
+		Height:         h.Height,
+		Time:           h.Time,
+		LastBlockId:    h.LastBlockId,
+		DataHash:       h.DataHash,
+		ValidatorsHash: h.ValidatorsHash,
+	}
+	return &id, &cmtproto.Block{Header: *h, Data: data}, &tmtypes.Block{Header: sdkHeader, Data: data}
+}
@@ -0,0 +1,17 @@
+package fakechain
+
+import (
+	"os"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
+)
+
+func TestMain(m *testing.M) {
+	params.InitCosmosSdk(
+		/* bech32Prefix= */ "wasm",
+		/* token= */ "cosm",
+	)
+	code := m.Run()
+	os.Exit(code)
+}
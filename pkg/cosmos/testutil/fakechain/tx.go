@@ -0,0 +1,422 @@
+package fakechain
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/client"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
+)
+
+// mempoolTx is a decoded tx. Only txs with a single signer are supported.
+type mempoolTx struct {
+	hash     string
+	bytes    []byte
+	tx       sdk.Tx // nil for unsigned simulations
+	proto    *txtypes.Tx
+	msgs     []sdk.Msg
+	signer   string
+	sig      signing.SignatureV2
+	gasLimit uint64
+	fee      sdk.Coins
+	timeout  uint64
+}
+
+// txMode is the mode a tx is processed in, like the baseapp run modes.
+type txMode int
+
+const (
+	modeCheck txMode = iota
+	modeSimulate
+	modeDeliver
+)
+
+func decodeTx(txBytes []byte) (*mempoolTx, error) {
+	tx, err := params.ClientTxConfig().TxDecoder()(txBytes)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrTxDecode, "unexpected tx type %T", tx)
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+	signers := sigTx.GetSigners()
+	if len(sigs) != 1 || len(signers) != 1 {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "expected a single signer, got %d signers and %d signatures", len(signers), len(sigs))
+	}
+	protoTx, ok := tx.(interface{ GetProtoTx() *txtypes.Tx })
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrTxDecode, "unexpected tx type %T", tx)
+	}
+	return &mempoolTx{
+		hash:     fmt.Sprintf("%X", tmhash.Sum(txBytes)),
+		bytes:    txBytes,
+		tx:       tx,
+		proto:    protoTx.GetProtoTx(),
+		msgs:     tx.GetMsgs(),
+		signer:   signers[0].String(),
+		sig:      sigs[0],
+		gasLimit: sigTx.GetGas(),
+		fee:      sigTx.GetFee(),
+		timeout:  sigTx.GetTimeoutHeight(),
+	}, nil
+}
+
+// gasUsed returns the gas used by a tx with n msgs.
+func (c *Chain) gasUsed(n int) uint64 {
+	return c.cfg.BaseGas + c.cfg.GasPerMsg*uint64(n)
+}
+
+// ante runs the checks of the ante handler against the state as of height. c.mu must be held.
+// In modeCheck and modeSimulate, the txs in the mempool are taken into account, like with the check state of a node.
+func (c *Chain) ante(tx *mempoolTx, height int64, mode txMode) error {
+	if tx.timeout > 0 && uint64(height) > tx.timeout {
+		return errorsmod.Wrapf(sdkerrors.ErrTxTimeoutHeight, "block height: %d, timeout height: %d", height, tx.timeout)
+	}
+	if mode == modeCheck && !c.cfg.MinGasPrices.IsZero() {
+		required := make(sdk.Coins, len(c.cfg.MinGasPrices))
+		for i, gp := range c.cfg.MinGasPrices {
+			required[i] = sdk.NewCoin(gp.Denom, gp.Amount.MulInt64(int64(tx.gasLimit)).Ceil().RoundInt())
+		}
+		if !tx.fee.IsAnyGTE(required) {
+			return errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", tx.fee, required)
+		}
+	}
+	acc, ok := c.accounts[tx.signer]
+	if !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", tx.signer)
+	}
+	sequence, balance := acc.sequence, acc.balance
+	if mode != modeDeliver {
+		for _, p := range c.mempool {
+			if p.signer == tx.signer {
+				sequence++
+				balance, _ = balance.SafeSub(p.fee...)
+			}
+		}
+	}
+	if _, negative := balance.SafeSub(tx.fee...); negative {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", balance, tx.fee)
+	}
+	if tx.sig.Sequence != sequence {
+		return errorsmod.Wrapf(sdkerrors.ErrWrongSequence, "account sequence mismatch, expected %d, got %d", sequence, tx.sig.Sequence)
+	}
+	if c.cfg.VerifySignatures && mode != modeSimulate {
+		if err := c.verifySignature(tx, acc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Chain) verifySignature(tx *mempoolTx, acc *account) error {
+	pubKey := tx.sig.PubKey
+	if pubKey == nil {
+		pubKey = acc.pubKey
+	}
+	if pubKey == nil || sdk.AccAddress(pubKey.Address()).String() != tx.signer {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "pubkey does not match signer address %s", tx.signer)
+	}
+	signerData := authsigning.SignerData{
+		Address:       tx.signer,
+		ChainID:       c.cfg.ChainID,
+		AccountNumber: acc.number,
+		Sequence:      tx.sig.Sequence,
+		PubKey:        pubKey,
+	}
+	if err := authsigning.VerifySignature(pubKey, signerData, tx.sig.Data, params.ClientTxConfig().SignModeHandler(), tx.tx); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signature verification failed; please verify account number (%d) and chain-id (%s)", acc.number, c.cfg.ChainID)
+	}
+	return nil
+}
+
+// Broadcast adds a tx to the mempool, if it passes the checks of the ante handler. The mode is ignored, as txs are only
+// included in blocks produced by NextBlock.
+func (c *Chain) Broadcast(txBytes []byte, mode txtypes.BroadcastMode) (*txtypes.BroadcastTxResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.failCall("Broadcast"); err != nil {
+		return nil, err
+	}
+	hash := fmt.Sprintf("%X", tmhash.Sum(txBytes))
+	if len(c.mempool) >= c.cfg.MempoolSize {
+		return rejected(hash, errorsmod.Wrap(sdkerrors.ErrMempoolIsFull, "mempool is full"))
+	}
+	if _, ok := c.txs[hash]; ok {
+		return rejected(hash, sdkerrors.ErrTxInMempoolCache)
+	}
+	for _, p := range c.mempool {
+		if p.hash == hash {
+			return rejected(hash, sdkerrors.ErrTxInMempoolCache)
+		}
+	}
+	tx, err := decodeTx(txBytes)
+	if err != nil {
+		return rejected(hash, err)
+	}
+	if err := c.ante(tx, c.height(), modeCheck); err != nil {
+		return rejected(hash, err)
+	}
+	c.mempool = append(c.mempool, tx)
+	return &txtypes.BroadcastTxResponse{TxResponse: &sdk.TxResponse{TxHash: hash, RawLog: "[]"}}, nil
+}
+
+// rejected returns the response to a tx rejected from the mempool with err, and its classified error.
+func rejected(hash string, err error) (*txtypes.BroadcastTxResponse, error) {
+	codespace, code, log := errorsmod.ABCIInfo(err, false)
+	res := &txtypes.BroadcastTxResponse{TxResponse: &sdk.TxResponse{TxHash: hash, Codespace: codespace, Code: code, RawLog: log}}
+	return res, client.NewABCIError(codespace, code, log, fmt.Errorf("tx failed with error code: %d, resp %v", code, res.TxResponse))
+}
+
+// recheck drops the txs which no longer pass the ante handler from the mempool, like CometBFT does after each block.
+// c.mu must be held.
+func (c *Chain) recheck() {
+	pending := c.mempool
+	c.mempool = nil
+	for _, tx := range pending {
+		if c.ante(tx, c.height(), modeCheck) == nil {
+			c.mempool = append(c.mempool, tx)
+		}
+	}
+}
+
+// deliverTx executes tx in the block at height, and returns its result. c.mu must be held.
+func (c *Chain) deliverTx(tx *mempoolTx, height int64) *sdk.TxResponse {
+	r := &sdk.TxResponse{
+		Height:    height,
+		TxHash:    tx.hash,
+		GasWanted: int64(tx.gasLimit),
+	}
+	if any, err := codectypes.NewAnyWithValue(tx.proto); err == nil {
+		r.Tx = any
+	}
+	fail := func(err error) *sdk.TxResponse {
+		r.Codespace, r.Code, r.RawLog = errorsmod.ABCIInfo(err, false)
+		return r
+	}
+	if err := c.ante(tx, height, modeDeliver); err != nil {
+		return fail(err)
+	}
+	acc := c.accounts[tx.signer]
+	acc.balance = acc.balance.Sub(tx.fee...)
+	acc.sequence++
+	if acc.pubKey == nil {
+		acc.pubKey = tx.sig.PubKey
+	}
+	r.Events = []abci.Event{
+		event(sdk.EventTypeTx, sdk.AttributeKeyFee, tx.fee.String(), sdk.AttributeKeyFeePayer, tx.signer),
+		event(sdk.EventTypeTx, sdk.AttributeKeyAccountSequence, fmt.Sprintf("%s/%d", tx.signer, tx.sig.Sequence)),
+	}
+	gasUsed := c.gasUsed(len(tx.msgs))
+	r.GasUsed = int64(gasUsed)
+	if gasUsed > tx.gasLimit {
+		return fail(errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: fakechain; gasWanted: %d, gasUsed: %d", tx.gasLimit, gasUsed))
+	}
+	logs, events, err := c.runMsgs(tx.msgs, false)
+	if err != nil {
+		return fail(err)
+	}
+	r.Logs = logs
+	r.RawLog = logs.String()
+	r.Events = append(r.Events, events...)
+	return r
+}
+
+// runMsgs simulates msgs, and executes them unless simulate is true.
+// Msgs are executed only if all of them succeed in simulation, so that a failing msg leaves no state behind.
+// c.mu must be held.
+func (c *Chain) runMsgs(msgs []sdk.Msg, simulate bool) (sdk.ABCIMessageLogs, []abci.Event, error) {
+	balances := make(map[string]sdk.Coins, len(c.accounts))
+	for addr, acc := range c.accounts {
+		balances[addr] = acc.balance
+	}
+	logs, events, err := c.execMsgs(msgs, balances, true)
+	if err != nil || simulate {
+		return logs, events, err
+	}
+	for addr := range balances {
+		balances[addr] = c.accounts[addr].balance
+	}
+	logs, events, err = c.execMsgs(msgs, balances, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	for addr, balance := range balances {
+		c.account(addr).balance = balance
+	}
+	return logs, events, nil
+}
+
+func (c *Chain) execMsgs(msgs []sdk.Msg, balances map[string]sdk.Coins, simulate bool) (sdk.ABCIMessageLogs, []abci.Event, error) {
+	var logs sdk.ABCIMessageLogs
+	var events []abci.Event
+	for i, msg := range msgs {
+		msgEvents, err := c.execMsg(msg, balances, simulate)
+		if err != nil {
+			return nil, nil, errorsmod.Wrapf(err, "failed to execute message; message index: %d", i)
+		}
+		logEvents := make(sdk.Events, len(msgEvents))
+		for j, e := range msgEvents {
+			logEvents[j] = sdk.Event(e)
+		}
+		logs = append(logs, sdk.NewABCIMessageLog(uint32(i), "", logEvents))
+		events = append(events, msgEvents...)
+	}
+	return logs, events, nil
+}
+
+func (c *Chain) execMsg(msg sdk.Msg, balances map[string]sdk.Coins, simulate bool) ([]abci.Event, error) {
+	if c.failMsg != nil {
+		if err := c.failMsg(msg); err != nil {
+			return nil, err
+		}
+	}
+	signers := msg.GetSigners()
+	if len(signers) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "msg has no signers")
+	}
+	events := []abci.Event{event(sdk.EventTypeMessage, sdk.AttributeKeyAction, sdk.MsgTypeURL(msg), sdk.AttributeKeySender, signers[0].String())}
+	switch m := msg.(type) {
+	case *banktypes.MsgSend:
+		if err := transfer(balances, m.FromAddress, m.ToAddress, m.Amount); err != nil {
+			return nil, err
+		}
+		events = append(events, event(banktypes.EventTypeTransfer, banktypes.AttributeKeyRecipient, m.ToAddress, banktypes.AttributeKeySender, m.FromAddress, sdk.AttributeKeyAmount, m.Amount.String()))
+	case *wasmtypes.MsgExecuteContract:
+		if err := transfer(balances, m.Sender, m.Contract, m.Funds); err != nil {
+			return nil, err
+		}
+		contractEvents, err := c.executeContract(m, simulate)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, contractEvents...)
+	default:
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message type: %T", msg)
+	}
+	return events, nil
+}
+
+// transfer moves amount from one balance to another.
+func transfer(balances map[string]sdk.Coins, from, to string, amount sdk.Coins) error {
+	if amount.IsZero() {
+		return nil
+	}
+	balance, negative := balances[from].SafeSub(amount...)
+	if negative {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", balances[from], amount)
+	}
+	balances[from] = balance
+	balances[to] = balances[to].Add(amount...)
+	return nil
+}
+
+// event returns an event of type typ, with attributes from alternating keys and values.
+func event(typ string, keyValues ...string) abci.Event {
+	e := abci.Event{Type: typ}
+	for i := 0; i+1 < len(keyValues); i += 2 {
+		e.Attributes = append(e.Attributes, abci.EventAttribute{Key: keyValues[i], Value: keyValues[i+1], Index: true})
+	}
+	return e
+}
+
+// simulate runs tx in modeSimulate. c.mu must be held.
+func (c *Chain) simulate(tx *mempoolTx) (*txtypes.SimulateResponse, error) {
+	err := c.ante(tx, c.height(), modeSimulate)
+	var events []abci.Event
+	if err == nil {
+		_, events, err = c.runMsgs(tx.msgs, true)
+	}
+	if err != nil {
+		// like the tx service, which drops the codespace and code of the error
+		return nil, client.ClassifyError(status.Errorf(codes.Unknown, "%v With gas wanted: '%d' and gas used: '%d' ", err, tx.gasLimit, c.gasUsed(len(tx.msgs))))
+	}
+	return &txtypes.SimulateResponse{
+		GasInfo: &sdk.GasInfo{GasWanted: tx.gasLimit, GasUsed: c.gasUsed(len(tx.msgs))},
+		Result:  &sdk.Result{Events: events},
+	}, nil
+}
+
+func (c *Chain) Simulate(txBytes []byte) (*txtypes.SimulateResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.failCall("Simulate"); err != nil {
+		return nil, err
+	}
+	tx, err := decodeTx(txBytes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return c.simulate(tx)
+}
+
+func (c *Chain) SimulateUnsigned(msgs []sdk.Msg, sequence uint64) (*txtypes.SimulateResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.failCall("SimulateUnsigned"); err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 || len(msgs[0].GetSigners()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no signer")
+	}
+	return c.simulate(&mempoolTx{
+		msgs:   msgs,
+		signer: msgs[0].GetSigners()[0].String(),
+		sig:    signing.SignatureV2{Sequence: sequence},
+	})
+}
+
+// BatchSimulateUnsigned simulates msgs like client.Client does, removing each failing msg and simulating the rest again.
+func (c *Chain) BatchSimulateUnsigned(msgs client.SimMsgs, sequence uint64) (*client.BatchSimResults, error) {
+	results := &client.BatchSimResults{}
+	toSim := msgs
+	for len(toSim) > 0 {
+		_, err := c.SimulateUnsigned(toSim.GetMsgs(), sequence)
+		failureIndex, containsFailure := client.FailedMsgIndex(err)
+		if err != nil && !containsFailure {
+			return nil, err
+		}
+		if !containsFailure {
+			results.Succeeded = append(results.Succeeded, toSim...)
+			break
+		}
+		results.Failed = append(results.Failed, toSim[failureIndex])
+		results.Succeeded = append(results.Succeeded, toSim[:failureIndex]...)
+		toSim = toSim[failureIndex+1:]
+	}
+	return results, nil
+}
+
+func (c *Chain) CreateAndSign(msgs []sdk.Msg, account uint64, sequence uint64, gasLimit uint64, gasLimitMultiplier float64, gasPrice sdk.DecCoin, signer cryptotypes.PrivKey, timeoutHeight uint64) ([]byte, error) {
+	return client.SignTx(c.cfg.ChainID, msgs, account, sequence, gasLimit, gasLimitMultiplier, gasPrice, signer, timeoutHeight)
+}
+
+func (c *Chain) SignAndBroadcast(msgs []sdk.Msg, account uint64, sequence uint64, gasPrice sdk.DecCoin, signer cryptotypes.PrivKey, mode txtypes.BroadcastMode) (*txtypes.BroadcastTxResponse, error) {
+	sim, err := c.SimulateUnsigned(msgs, sequence)
+	if err != nil {
+		return nil, err
+	}
+	txBytes, err := c.CreateAndSign(msgs, account, sequence, sim.GasInfo.GasUsed, client.DefaultGasLimitMultiplier, gasPrice, signer, 0)
+	if err != nil {
+		return nil, err
+	}
+	return c.Broadcast(txBytes, mode)
+}
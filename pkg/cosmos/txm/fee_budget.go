@@ -0,0 +1,75 @@
+package txm
+
+import (
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// feeSpend is a fee paid by a sender, in the gas token.
+type feeSpend struct {
+	at     time.Time
+	amount sdk.Dec
+}
+
+// feeBudget tracks the fees spent by each sender over a rolling period.
+// Spending is kept in memory, so a restart resets the budgets.
+type feeBudget struct {
+	now func() time.Time
+
+	mu    sync.Mutex
+	spent map[string][]feeSpend
+}
+
+func newFeeBudget() *feeBudget {
+	return &feeBudget{now: time.Now, spent: map[string][]feeSpend{}}
+}
+
+// record adds a fee paid by sender.
+func (b *feeBudget) record(sender string, amount sdk.Dec) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.spent[sender] = append(b.spent[sender], feeSpend{at: b.now(), amount: amount})
+}
+
+// spentBy returns the fees paid by sender within the last period.
+func (b *feeBudget) spentBy(sender string, period time.Duration) sdk.Dec {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.prune(sender, period)
+}
+
+// usage returns the fees paid by each sender within the last period.
+func (b *feeBudget) usage(period time.Duration) map[string]sdk.Dec {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	usage := make(map[string]sdk.Dec, len(b.spent))
+	for sender := range b.spent {
+		if spent := b.prune(sender, period); spent.IsPositive() {
+			usage[sender] = spent
+		}
+	}
+	return usage
+}
+
+// prune forgets the fees of sender older than period, and returns the sum of the rest.
+func (b *feeBudget) prune(sender string, period time.Duration) sdk.Dec {
+	cutoff := b.now().Add(-period)
+	spends := b.spent[sender]
+	i := 0
+	for i < len(spends) && !spends[i].at.After(cutoff) {
+		i++
+	}
+	spends = spends[i:]
+	if len(spends) == 0 {
+		delete(b.spent, sender)
+		return sdk.ZeroDec()
+	}
+	b.spent[sender] = spends
+	total := sdk.ZeroDec()
+	for _, s := range spends {
+		total = total.Add(s.amount)
+	}
+	return total
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/pkg/errors"
 	"golang.org/x/crypto/ripemd160" //nolint: staticcheck
+	"golang.org/x/exp/maps"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/loop"
 )
@@ -90,6 +91,17 @@ func (ka *keystoreAdapter) updateMappingLocked() error {
 	return nil
 }
 
+// Accounts returns the bech32 addresses of the keystore accounts.
+func (ka *keystoreAdapter) Accounts() ([]string, error) {
+	ka.mutex.Lock()
+	defer ka.mutex.Unlock()
+	err := ka.updateMappingLocked()
+	if err != nil {
+		return nil, err
+	}
+	return maps.Keys(ka.addressToPubKey), nil
+}
+
 func (ka *keystoreAdapter) lookup(id string) (*accountInfo, error) {
 	ka.mutex.RLock()
 	ai, ok := ka.addressToPubKey[id]
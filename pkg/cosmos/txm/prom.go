@@ -0,0 +1,16 @@
+package txm
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	promCosmosTxmBroadcastFeeDenom = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cosmos_txm_broadcast_txs",
+			Help: "Counts the txs broadcast by the txm, by the denom which paid their fee.",
+		},
+		[]string{"chain_id", "fee_denom"},
+	)
+)
@@ -0,0 +1,64 @@
+package txm
+
+import (
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/adapters"
+)
+
+// refusal is the last reason a msg was refused to be sent.
+type refusal struct {
+	at  time.Time
+	err error
+}
+
+// refusals tracks why msgs were refused to be sent, e.g. for exceeding MaxFeePerTx, so that GetMsgs callers can
+// tell a refused msg from one which timed out. Refusals are kept in memory, so a restart forgets them.
+type refusals struct {
+	now func() time.Time
+
+	mu   sync.Mutex
+	msgs map[int64]refusal
+}
+
+func newRefusals() *refusals {
+	return &refusals{now: time.Now, msgs: map[int64]refusal{}}
+}
+
+// refuse records err as the reason the msgs with ids were refused. Refusals are kept for retention after the last
+// one, so that they outlive msgs which expire after being refused.
+func (r *refusals) refuse(ids []int64, err error, retention time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.now()
+	cutoff := now.Add(-retention)
+	for id, ref := range r.msgs {
+		if ref.at.Before(cutoff) {
+			delete(r.msgs, id)
+		}
+	}
+	for _, id := range ids {
+		r.msgs[id] = refusal{at: now, err: err}
+	}
+}
+
+// clear forgets the refusals of the msgs with ids, once they are sent.
+func (r *refusals) clear(ids []int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, id := range ids {
+		delete(r.msgs, id)
+	}
+}
+
+// annotate sets the Refusal of msgs.
+func (r *refusals) annotate(msgs adapters.Msgs) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range msgs {
+		if ref, ok := r.msgs[msgs[i].ID]; ok {
+			msgs[i].Refusal = ref.err
+		}
+	}
+}
@@ -7,11 +7,13 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
+	"go.uber.org/multierr"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/cometbft/cometbft/crypto/tmhash"
@@ -20,9 +22,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/adapters"
+	injectivetypes "github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/adapters/injective/types"
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/client"
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/config"
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/db"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/denom"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/loop"
@@ -39,29 +44,49 @@ var (
 type Txm struct {
 	services.StateMachine
 	newMsgs         chan struct{}
+	chainID         string
 	orm             *ORM
 	lggr            logger.Logger
 	tc              func() (client.ReaderWriter, error)
+	heads           client.HeadReader
 	keystoreAdapter *keystoreAdapter
 	stop, done      chan struct{}
 	cfg             config.Config
-	gpe             client.ComposedGasPriceEstimator
+	bech32          params.Bech32Prefix
+	gpe             client.GasPricesEstimator
+
+	mu          sync.RWMutex
+	gasPriceErr error // last error getting the gas price, reported as unhealthy
+	feeBudget   *feeBudget
+	refusals    *refusals
 }
 
+var (
+	// ErrFeeCapExceeded is returned for batches whose fee exceeds MaxFeePerTx.
+	ErrFeeCapExceeded = errors.New("fee exceeds the max fee per tx")
+	// ErrFeeBudgetExceeded is returned for batches whose fee exceeds the remaining FeeBudget of their sender.
+	ErrFeeBudgetExceeded = errors.New("fee exceeds the remaining fee budget")
+)
+
 // NewTxm creates a txm. Uses simulation so should only be used to send txes to trusted contracts i.e. OCR.
-func NewTxm(db *sqlx.DB, tc func() (client.ReaderWriter, error), gpe client.ComposedGasPriceEstimator, chainID string, cfg config.Config, ks loop.Keystore, lggr logger.Logger) *Txm {
+func NewTxm(db *sqlx.DB, tc func() (client.ReaderWriter, error), heads client.HeadReader, gpe client.GasPricesEstimator, chainID string, cfg config.Config, ks loop.Keystore, lggr logger.Logger) *Txm {
 	lggr = logger.Named(lggr, "Txm")
 	keystoreAdapter := newKeystoreAdapter(ks, cfg.Bech32Prefix())
 	return &Txm{
 		newMsgs:         make(chan struct{}, 1), // buffered to hold one pending request while unblocking callers
+		chainID:         chainID,
 		orm:             NewORM(chainID, db),
 		lggr:            lggr,
 		tc:              tc,
+		heads:           heads,
 		keystoreAdapter: keystoreAdapter,
 		stop:            make(chan struct{}),
 		done:            make(chan struct{}),
 		cfg:             cfg,
+		bech32:          params.Bech32Prefix(cfg.Bech32Prefix()),
 		gpe:             gpe,
+		feeBudget:       newFeeBudget(),
+		refusals:        newRefusals(),
 	}
 }
 
@@ -75,7 +100,36 @@ func (txm *Txm) Start(context.Context) error {
 
 func (txm *Txm) Name() string { return txm.lggr.Name() }
 
-func (txm *Txm) HealthReport() map[string]error { return map[string]error{txm.Name(): txm.Healthy()} }
+func (txm *Txm) HealthReport() map[string]error {
+	err := txm.Healthy()
+	txm.mu.RLock()
+	if txm.gasPriceErr != nil {
+		err = multierr.Append(err, fmt.Errorf("failed to get gas price: %w", txm.gasPriceErr))
+	}
+	txm.mu.RUnlock()
+	report := map[string]error{txm.Name(): err}
+	if budget := txm.cfg.FeeBudget(); budget.IsPositive() {
+		period := txm.cfg.FeeBudgetPeriod()
+		for sender, spent := range txm.FeeBudgetUsage() {
+			var err error
+			if spent.GTE(budget) {
+				err = fmt.Errorf("spent %s of fee budget %s%s in the last %s", spent, budget, txm.cfg.GasToken(), period)
+			}
+			report[txm.Name()+".FeeBudget."+sender] = err
+		}
+	}
+	return report
+}
+
+// Accounts returns the bech32 addresses of the keystore accounts, which txs can be sent from.
+func (txm *Txm) Accounts() ([]string, error) {
+	return txm.keystoreAdapter.Accounts()
+}
+
+// FeeBudgetUsage returns the fees paid by each sender within the last FeeBudgetPeriod, in the gas token.
+func (txm *Txm) FeeBudgetUsage() map[string]sdk.Dec {
+	return txm.feeBudget.usage(txm.cfg.FeeBudgetPeriod())
+}
 
 func (txm *Txm) confirmAnyUnconfirmed(ctx context.Context) {
 	// Confirm any broadcasted but not confirmed txes.
@@ -135,6 +189,7 @@ func (txm *Txm) run() {
 var (
 	typeMsgSend            = sdk.MsgTypeURL(&types.MsgSend{})
 	typeMsgExecuteContract = sdk.MsgTypeURL(&wasmtypes.MsgExecuteContract{})
+	typeMsgTransmit        = sdk.MsgTypeURL(&injectivetypes.MsgTransmit{})
 )
 
 func unmarshalMsg(msgType string, raw []byte) (sdk.Msg, string, error) {
@@ -153,6 +208,13 @@ func unmarshalMsg(msgType string, raw []byte) (sdk.Msg, string, error) {
 			return nil, "", err
 		}
 		return &ms, ms.Sender, nil
+	case typeMsgTransmit:
+		var ms injectivetypes.MsgTransmit
+		err := ms.Unmarshal(raw)
+		if err != nil {
+			return nil, "", err
+		}
+		return &ms, ms.Transmitter, nil
 	}
 	return nil, "", errors.Errorf("unrecognized message type: %s", msgType)
 }
@@ -239,7 +301,7 @@ func (txm *Txm) sendMsgBatch(ctx context.Context) {
 			continue
 		}
 		m.DecodedMsg = msg
-		_, err2 = sdk.AccAddressFromBech32(sender)
+		_, err2 = txm.bech32.Parse(sender)
 		if err2 != nil {
 			// Should never happen, we parse sender on Enqueue
 			logger.Criticalw(txm.lggr, "Unable to parse sender", "err", err2, "sender", sender)
@@ -249,17 +311,20 @@ func (txm *Txm) sendMsgBatch(ctx context.Context) {
 	}
 
 	txm.lggr.Debugw("msgsByFrom", "msgsByFrom", msgsByFrom)
-	gasPrice, err := txm.GasPrice()
+	gasPrices, err := txm.gpe.GasPrices()
+	txm.mu.Lock()
+	txm.gasPriceErr = err
+	txm.mu.Unlock()
 	if err != nil {
-		// Should be impossible
-		logger.Criticalw(txm.lggr, "Failed to get gas price", "err", err)
+		// The msgs remain Started, and are retried with the next batch.
+		txm.lggr.Errorw("Failed to get gas price, skipping batch", "err", err)
 		return
 	}
 	for s, msgs := range msgsByFrom {
-		sender, _ := sdk.AccAddressFromBech32(s) // Already checked validity above
-		err := txm.sendMsgBatchFromAddress(ctx, gasPrice, sender, msgs)
+		sender, _ := txm.bech32.Parse(s) // Already checked validity above
+		err := txm.sendMsgBatchFromAddress(ctx, gasPrices, sender, msgs)
 		if err != nil {
-			txm.lggr.Errorw("Could not send message batch", "err", err, "from", sender.String())
+			txm.lggr.Errorw("Could not send message batch", "err", err, "from", s)
 			continue
 		}
 		if ctx.Err() != nil {
@@ -269,7 +334,8 @@ func (txm *Txm) sendMsgBatch(ctx context.Context) {
 
 }
 
-func (txm *Txm) sendMsgBatchFromAddress(ctx context.Context, gasPrice sdk.DecCoin, sender sdk.AccAddress, msgs adapters.Msgs) error {
+func (txm *Txm) sendMsgBatchFromAddress(ctx context.Context, gasPrices map[string]sdk.DecCoin, sender sdk.AccAddress, msgs adapters.Msgs) error {
+	from := txm.bech32.Address(sender)
 	tc, err := txm.tc()
 	if err != nil {
 		logger.Criticalw(txm.lggr, "unable to get client", "err", err)
@@ -277,33 +343,33 @@ func (txm *Txm) sendMsgBatchFromAddress(ctx context.Context, gasPrice sdk.DecCoi
 	}
 	an, sn, err := tc.Account(sender)
 	if err != nil {
-		txm.lggr.Warnw("unable to read account", "err", err, "from", sender.String())
+		txm.lggr.Warnw("unable to read account", "err", err, "from", from)
 		// If we can't read the account, assume transient api issues and leave msgs unstarted
 		// to retry on next poll.
 		return err
 	}
 
-	txm.lggr.Debugw("simulating batch", "from", sender, "msgs", msgs, "seqnum", sn)
+	txm.lggr.Debugw("simulating batch", "from", from, "msgs", msgs, "seqnum", sn)
 	simResults, err := tc.BatchSimulateUnsigned(msgs.GetSimMsgs(), sn)
 	if err != nil {
-		txm.lggr.Warnw("unable to simulate", "err", err, "from", sender.String())
+		txm.lggr.Warnw("unable to simulate", "err", err, "from", from)
 		// If we can't simulate assume transient api issue and retry on next poll.
 		// Note one rare scenario in which this can happen: the cosmos node misbehaves
 		// in that it confirms a txhash is present but still gives an old seq num.
 		// This is benign as the next retry will succeeds.
 		return err
 	}
-	txm.lggr.Debugw("simulation results", "from", sender, "succeeded", simResults.Succeeded, "failed", simResults.Failed)
+	txm.lggr.Debugw("simulation results", "from", from, "succeeded", simResults.Succeeded, "failed", simResults.Failed)
 	err = txm.orm.UpdateMsgs(ctx, simResults.Failed.GetSimMsgsIDs(), db.Errored, nil)
 	if err != nil {
-		txm.lggr.Errorw("unable to mark failed sim txes as errored", "err", err, "from", sender.String())
+		txm.lggr.Errorw("unable to mark failed sim txes as errored", "err", err, "from", from)
 		// If we can't mark them as failed retry on next poll. Presumably same ones will fail.
 		return err
 	}
 
 	// Continue if there are no successful txes
 	if len(simResults.Succeeded) == 0 {
-		txm.lggr.Warnw("all sim msgs errored, not sending tx", "from", sender.String())
+		txm.lggr.Warnw("all sim msgs errored, not sending tx", "from", from)
 		return errors.New("all sim msgs errored")
 	}
 	// Get the gas limit for the successful batch
@@ -314,24 +380,38 @@ func (txm *Txm) sendMsgBatchFromAddress(ctx context.Context, gasPrice sdk.DecCoi
 		return err
 	}
 	gasLimit := s.GasInfo.GasUsed
+	gasPrice, err := txm.feeGasPrice(tc, gasPrices, sender, gasLimit)
+	if err != nil {
+		txm.lggr.Warnw("unable to pay fee", "err", err, "from", from)
+		// Retry on next poll, once the sender is funded.
+		return err
+	}
 
-	lb, err := tc.LatestBlock()
+	head, err := txm.heads.LatestHead(ctx)
 	if err != nil {
-		txm.lggr.Warnw("unable to get latest block", "err", err, "from", sender.String())
+		txm.lggr.Warnw("unable to get latest head", "err", err, "from", from)
 		// Assume transient api issue and retry.
 		return err
 	}
-	header, timeout := lb.SdkBlock.Header.Height, txm.cfg.BlocksUntilTxTimeout()
+	header, timeout := head.Height, txm.cfg.BlocksUntilTxTimeout()
 	if header < 0 {
 		return fmt.Errorf("invalid negative header height: %d", header)
 	} else if timeout < 0 {
 		return fmt.Errorf("invalid negative blocks until tx timeout: %d", timeout)
 	}
 	timeoutHeight := uint64(header) + uint64(timeout)
+	fee, err := txm.checkFee(from, client.TxFee(gasLimit, txm.cfg.GasLimitMultiplier(), gasPrice))
+	if err != nil {
+		// Leave the msgs Started, to retry once gas prices drop or the budget rolls over, until they expire.
+		// The reason is kept for GetMsgs callers, which could not tell them from timed out msgs otherwise.
+		txm.lggr.Errorw("refusing to sign batch", "reason", err, "from", from, "gasLimit", gasLimit, "gasPrice", gasPrice.String())
+		txm.refusals.refuse(simResults.Succeeded.GetSimMsgsIDs(), err, txm.cfg.TxMsgTimeout())
+		return err
+	}
 	signedTx, err := tc.CreateAndSign(simResults.Succeeded.GetMsgs(), an, sn, gasLimit, txm.cfg.GasLimitMultiplier(),
-		gasPrice, NewKeyWrapper(txm.keystoreAdapter, sender.String()), timeoutHeight)
+		gasPrice, NewKeyWrapper(txm.keystoreAdapter, from), timeoutHeight)
 	if err != nil {
-		txm.lggr.Errorw("unable to sign tx", "err", err, "from", sender.String())
+		txm.lggr.Errorw("unable to sign tx", "err", err, "from", from)
 		return err
 	}
 
@@ -348,11 +428,11 @@ func (txm *Txm) sendMsgBatchFromAddress(ctx context.Context, gasPrice sdk.DecCoi
 			return err
 		}
 
-		txm.lggr.Infow("broadcasting tx", "from", sender, "msgs", simResults.Succeeded, "gasLimit", gasLimit, "gasPrice", gasPrice.String(), "timeoutHeight", timeoutHeight, "hash", txHash)
+		txm.lggr.Infow("broadcasting tx", "from", from, "msgs", simResults.Succeeded, "gasLimit", gasLimit, "gasPrice", gasPrice.String(), "feeDenom", gasPrice.Denom, "timeoutHeight", timeoutHeight, "hash", txHash)
 		resp, err = tc.Broadcast(signedTx, txtypes.BroadcastMode_BROADCAST_MODE_SYNC)
 		if err != nil {
 			// Rollback marking as broadcasted
-			// Note can happen if the node's mempool is full, where we expect errCode 20.
+			// Note can happen if the node's mempool is full, see client.ErrMempoolFull.
 			return err
 		}
 		if resp.TxResponse == nil {
@@ -366,11 +446,18 @@ func (txm *Txm) sendMsgBatchFromAddress(ctx context.Context, gasPrice sdk.DecCoi
 		return nil
 	})
 	if err != nil {
-		txm.lggr.Errorw("error broadcasting tx", "err", err, "from", sender.String())
+		txm.lggr.Errorw("error broadcasting tx", "err", err, "from", from)
 		// Was unable to broadcast, retry on next poll
 		return err
 	}
 
+	promCosmosTxmBroadcastFeeDenom.WithLabelValues(txm.chainID, gasPrice.Denom).Inc()
+	txm.refusals.clear(simResults.Succeeded.GetSimMsgsIDs())
+	// The fee is spent once the tx is in the mempool, even if it fails.
+	if fee.IsPositive() {
+		txm.feeBudget.record(from, fee)
+	}
+
 	maxPolls, pollPeriod := txm.confirmPollConfig()
 	if err := txm.confirmTx(ctx, tc, resp.TxResponse.TxHash, simResults.Succeeded.GetSimMsgsIDs(), maxPolls, pollPeriod); err != nil {
 		txm.lggr.Errorw("error confirming tx", "err", err, "hash", resp.TxResponse.TxHash)
@@ -411,7 +498,7 @@ func (txm *Txm) confirmTx(ctx context.Context, tc client.Reader, txHash string,
 		// so we can build a new batch
 		tx, err := tc.Tx(txHash)
 		if err != nil {
-			if strings.Contains(err.Error(), "not found") {
+			if errors.Is(err, client.ErrNotFound) {
 				txm.lggr.Infow("txhash not found yet, still confirming", "hash", txHash)
 			} else {
 				txm.lggr.Errorw("error looking for hash of tx", "err", err, "hash", txHash)
@@ -424,7 +511,7 @@ func (txm *Txm) confirmTx(ctx context.Context, tc client.Reader, txHash string,
 			continue
 		}
 
-		txm.lggr.Infow("successfully sent batch", "hash", txHash, "msgs", broadcasted)
+		txm.lggr.Infow("successfully sent batch", "hash", txHash, "msgs", broadcasted, "fee", tx.Tx.GetAuthInfo().GetFee().GetAmount().String())
 		// If confirmed mark these as completed.
 		err = txm.orm.UpdateMsgs(ctx, broadcasted, db.Confirmed, nil)
 		if err != nil {
@@ -491,19 +578,26 @@ func (e *ErrMsgUnsupported) Error() string {
 func (txm *Txm) marshalMsg(msg sdk.Msg) (string, []byte, error) {
 	switch ms := msg.(type) {
 	case *wasmtypes.MsgExecuteContract:
-		_, err := sdk.AccAddressFromBech32(ms.Sender)
+		_, err := txm.bech32.Parse(ms.Sender)
 		if err != nil {
 			txm.lggr.Errorw("failed to parse sender, skipping", "err", err, "sender", ms.Sender)
 			return "", nil, err
 		}
 
 	case *types.MsgSend:
-		_, err := sdk.AccAddressFromBech32(ms.FromAddress)
+		_, err := txm.bech32.Parse(ms.FromAddress)
 		if err != nil {
 			txm.lggr.Errorw("failed to parse sender, skipping", "err", err, "sender", ms.FromAddress)
 			return "", nil, err
 		}
 
+	case *injectivetypes.MsgTransmit:
+		_, err := txm.bech32.Parse(ms.Transmitter)
+		if err != nil {
+			txm.lggr.Errorw("failed to parse sender, skipping", "err", err, "sender", ms.Transmitter)
+			return "", nil, err
+		}
+
 	default:
 		return "", nil, &ErrMsgUnsupported{Msg: msg}
 	}
@@ -516,14 +610,22 @@ func (txm *Txm) marshalMsg(msg sdk.Msg) (string, []byte, error) {
 	return typeURL, raw, nil
 }
 
-// GetMsgs returns any messages matching ids.
+// GetMsgs returns any messages matching ids, with the reason of any refusal to send them.
 func (txm *Txm) GetMsgs(ctx context.Context, ids ...int64) (adapters.Msgs, error) {
-	return txm.orm.GetMsgs(ctx, ids...)
+	msgs, err := txm.orm.GetMsgs(ctx, ids...)
+	if err != nil {
+		return nil, err
+	}
+	txm.refusals.annotate(msgs)
+	return msgs, nil
 }
 
 // GasPrice returns the gas price from the estimator in the configured fee token.
 func (txm *Txm) GasPrice() (sdk.DecCoin, error) {
-	prices := txm.gpe.GasPrices()
+	prices, err := txm.gpe.GasPrices()
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
 	gasPrice, ok := prices[txm.cfg.GasToken()]
 	if !ok {
 		return sdk.DecCoin{}, errors.New("unexpected empty gas price")
@@ -531,6 +633,77 @@ func (txm *Txm) GasPrice() (sdk.DecCoin, error) {
 	return gasPrice, nil
 }
 
+// feeGasPrice returns the gas price to pay the fee of a tx with gasLimit at, in the first of the configured fee denoms
+// which sender has enough balance for.
+func (txm *Txm) feeGasPrice(tc client.Reader, gasPrices map[string]sdk.DecCoin, sender sdk.AccAddress, gasLimit uint64) (sdk.DecCoin, error) {
+	feeDenoms := txm.cfg.FeeDenoms()
+	var errs error
+	for _, feeDenom := range feeDenoms {
+		gasPrice, err := txm.gasPriceIn(gasPrices, feeDenom)
+		if err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		if len(feeDenoms) == 1 {
+			// Nothing to choose from, so leave checking the balance to the chain.
+			return gasPrice, nil
+		}
+		balance, err := tc.Balance(sender, feeDenom)
+		if err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("failed to get %s balance: %w", feeDenom, err))
+			continue
+		}
+		fee := client.TxFee(gasLimit, txm.cfg.GasLimitMultiplier(), gasPrice)
+		if balance.Amount.LT(fee.Amount) {
+			errs = multierr.Append(errs, fmt.Errorf("balance %s is less than fee %s", balance, fee))
+			continue
+		}
+		return gasPrice, nil
+	}
+	return sdk.DecCoin{}, fmt.Errorf("unable to pay fee in any of %v: %w", feeDenoms, errs)
+}
+
+// checkFee returns fee in the gas token, or an error if it exceeds MaxFeePerTx or the remaining FeeBudget of sender.
+// The fee is returned even without caps, so that the fees paid are recorded for FeeBudgetUsage.
+func (txm *Txm) checkFee(sender string, fee sdk.Coin) (sdk.Dec, error) {
+	maxFee, budget := txm.cfg.MaxFeePerTx(), txm.cfg.FeeBudget()
+	converted, err := denom.ConvertDecCoin(sdk.NewDecCoinFromCoin(fee), txm.cfg.GasToken())
+	if err != nil {
+		if !maxFee.IsPositive() && !budget.IsPositive() {
+			return sdk.ZeroDec(), nil // not paid in the gas token, so nothing to check or record
+		}
+		// Refuse fees which cannot be checked against the limits.
+		return sdk.Dec{}, fmt.Errorf("unable to convert fee %s to %s: %w", fee, txm.cfg.GasToken(), err)
+	}
+	amount := converted.Amount
+	if maxFee.IsPositive() && amount.GT(maxFee) {
+		return sdk.Dec{}, fmt.Errorf("%w: %s is more than %s%s", ErrFeeCapExceeded, fee, maxFee, txm.cfg.GasToken())
+	}
+	if budget.IsPositive() {
+		period := txm.cfg.FeeBudgetPeriod()
+		if spent := txm.feeBudget.spentBy(sender, period); spent.Add(amount).GT(budget) {
+			return sdk.Dec{}, fmt.Errorf("%w: %s on top of %s spent in the last %s is more than %s%s", ErrFeeBudgetExceeded, fee, spent, period, budget, txm.cfg.GasToken())
+		}
+	}
+	return amount, nil
+}
+
+// gasPriceIn returns the estimated gas price in feeDenom, converting the gas token price if there is none.
+func (txm *Txm) gasPriceIn(gasPrices map[string]sdk.DecCoin, feeDenom string) (sdk.DecCoin, error) {
+	if gasPrice, ok := gasPrices[feeDenom]; ok {
+		return gasPrice, nil
+	}
+	gasPrice, ok := gasPrices[txm.cfg.GasToken()]
+	if !ok {
+		return sdk.DecCoin{}, fmt.Errorf("no gas price for %s", feeDenom)
+	}
+	converted, err := denom.ConvertDecCoin(gasPrice, feeDenom)
+	if err != nil {
+		return sdk.DecCoin{}, fmt.Errorf("no gas price for %s: %w", feeDenom, err)
+	}
+	return converted, nil
+}
+
 func (txm *Txm) Close() error {
 	return txm.StopOnce("Txm", func() error {
 		close(txm.stop)
@@ -2,18 +2,22 @@ package txm
 
 import (
 	"context"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"slices"
 	"testing"
 	"time"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
-	tmservicetypes "github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cosmostypes "github.com/cosmos/cosmos-sdk/types"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/google/uuid"
-	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -22,10 +26,15 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/adapters"
+	injectivetypes "github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/adapters/injective/types"
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/client"
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/client/mocks"
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/config"
 	cosmosdb "github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/db"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/monitor"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/testutil/fakechain"
 )
 
 func generateExecuteMsg(msg []byte, from, to cosmostypes.AccAddress) cosmostypes.Msg {
@@ -44,6 +53,11 @@ func newReaderWriterMock(t *testing.T) *mocks.ReaderWriter {
 	return tc
 }
 
+// newHeadTracker returns a HeadTracker which queries tc for every head.
+func newHeadTracker(tc client.Reader, lggr logger.Logger) *client.HeadTracker {
+	return client.NewHeadTracker(func() (client.Reader, error) { return tc, nil }, nil, 0, lggr)
+}
+
 func TestTxm(t *testing.T) {
 	lggr := logger.Test(t)
 	db := NewDB(t)
@@ -71,20 +85,20 @@ func TestTxm(t *testing.T) {
 		GasToken:        &gasToken,
 	}}
 	cfg.SetDefaults()
-	gpe := client.NewMustGasPriceEstimator([]client.GasPricesEstimator{
-		client.NewFixedGasPriceEstimator(map[string]cosmostypes.DecCoin{
+	gpe := client.NewComposedGasPriceEstimator([]client.GasPriceStage{{
+		Estimator: client.NewFixedGasPriceEstimator(map[string]cosmostypes.DecCoin{
 			cfg.GasToken(): cosmostypes.NewDecCoinFromDec(cfg.GasToken(), cosmostypes.MustNewDecFromStr("0.01")),
 		},
 			lggr.(logger.SugaredLogger),
 		),
-	}, lggr)
+	}}, lggr)
 
 	t.Run("single msg", func(t *testing.T) {
 		ctx := tests.Context(t)
 		tc := newReaderWriterMock(t)
 		tcFn := func() (client.ReaderWriter, error) { return tc, nil }
 		loopKs := newKeystore(1)
-		txm := NewTxm(db, tcFn, *gpe, chainID, cfg, loopKs, lggr)
+		txm := NewTxm(db, tcFn, newHeadTracker(tc, lggr), gpe, chainID, cfg, loopKs, lggr)
 
 		// Enqueue a single msg, then send it in a batch
 		id1, err := txm.Enqueue(ctx, contract.String(), generateExecuteMsg([]byte(`1`), sender1, contract))
@@ -100,9 +114,7 @@ func TestTxm(t *testing.T) {
 		tc.On("SimulateUnsigned", mock.Anything, mock.Anything).Return(&txtypes.SimulateResponse{GasInfo: &cosmostypes.GasInfo{
 			GasUsed: 1_000_000,
 		}}, nil)
-		tc.On("LatestBlock").Return(&tmservicetypes.GetLatestBlockResponse{SdkBlock: &tmservicetypes.Block{
-			Header: tmservicetypes.Header{Height: 1},
-		}}, nil)
+		tc.On("Status").Return(&coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: 1}}, nil)
 		tc.On("CreateAndSign", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte{0x01}, nil)
 
 		txResp := &cosmostypes.TxResponse{TxHash: "4BF5122F344554C53BDE2EBB8CD2B7E3D1600AD631C385A5D7CCE23C7785459A"}
@@ -115,6 +127,11 @@ func TestTxm(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, 1, len(completed))
 		assert.Equal(t, completed[0].State, cosmosdb.Confirmed)
+		// the fee is recorded without caps, for the balance runway
+		usage := txm.FeeBudgetUsage()
+		require.Contains(t, usage, sender1.String())
+		assert.True(t, usage[sender1.String()].IsPositive())
+		assert.Equal(t, float64(1), testutil.ToFloat64(promCosmosTxmBroadcastFeeDenom.WithLabelValues(chainID, gasToken)))
 	})
 
 	t.Run("two msgs different accounts", func(t *testing.T) {
@@ -122,7 +139,7 @@ func TestTxm(t *testing.T) {
 		tc := newReaderWriterMock(t)
 		tcFn := func() (client.ReaderWriter, error) { return tc, nil }
 		loopKs := newKeystore(1)
-		txm := NewTxm(db, tcFn, *gpe, chainID, cfg, loopKs, lggr)
+		txm := NewTxm(db, tcFn, newHeadTracker(tc, lggr), gpe, chainID, cfg, loopKs, lggr)
 
 		id1, err := txm.Enqueue(ctx, contract.String(), generateExecuteMsg([]byte(`0`), sender1, contract))
 		require.NoError(t, err)
@@ -157,9 +174,7 @@ func TestTxm(t *testing.T) {
 		tc.On("SimulateUnsigned", mock.Anything, mock.Anything).Return(&txtypes.SimulateResponse{GasInfo: &cosmostypes.GasInfo{
 			GasUsed: 1_000_000,
 		}}, nil).Once()
-		tc.On("LatestBlock").Return(&tmservicetypes.GetLatestBlockResponse{SdkBlock: &tmservicetypes.Block{
-			Header: tmservicetypes.Header{Height: 1},
-		}}, nil).Once()
+		tc.On("Status").Return(&coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: 1}}, nil).Once()
 		tc.On("CreateAndSign", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte{0x01}, nil).Once()
 		txResp := &cosmostypes.TxResponse{TxHash: "4BF5122F344554C53BDE2EBB8CD2B7E3D1600AD631C385A5D7CCE23C7785459A"}
 		tc.On("Broadcast", mock.Anything, mock.Anything).Return(&txtypes.BroadcastTxResponse{TxResponse: txResp}, nil).Once()
@@ -179,7 +194,7 @@ func TestTxm(t *testing.T) {
 		tc := newReaderWriterMock(t)
 		tcFn := func() (client.ReaderWriter, error) { return tc, nil }
 		loopKs := newKeystore(1)
-		txm := NewTxm(db, tcFn, *gpe, chainID, cfg, loopKs, lggr)
+		txm := NewTxm(db, tcFn, newHeadTracker(tc, lggr), gpe, chainID, cfg, loopKs, lggr)
 
 		id1, err := txm.Enqueue(ctx, contract.String(), generateExecuteMsg([]byte(`0`), sender1, contract))
 		require.NoError(t, err)
@@ -217,9 +232,7 @@ func TestTxm(t *testing.T) {
 			tc.On("SimulateUnsigned", mock.Anything, mock.Anything).Return(&txtypes.SimulateResponse{GasInfo: &cosmostypes.GasInfo{
 				GasUsed: 1_000_000,
 			}}, nil).Once()
-			tc.On("LatestBlock").Return(&tmservicetypes.GetLatestBlockResponse{SdkBlock: &tmservicetypes.Block{
-				Header: tmservicetypes.Header{Height: 1},
-			}}, nil).Once()
+			tc.On("Status").Return(&coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: 1}}, nil).Once()
 			tc.On("CreateAndSign", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte{0x01}, nil).Once()
 		}
 		txResp := &cosmostypes.TxResponse{TxHash: "4BF5122F344554C53BDE2EBB8CD2B7E3D1600AD631C385A5D7CCE23C7785459A"}
@@ -241,10 +254,10 @@ func TestTxm(t *testing.T) {
 		tc.On("Tx", mock.Anything).Return(&txtypes.GetTxResponse{
 			Tx:         &txtypes.Tx{},
 			TxResponse: &cosmostypes.TxResponse{TxHash: "0x123"},
-		}, errors.New("not found")).Twice()
+		}, client.ErrNotFound).Twice()
 		tcFn := func() (client.ReaderWriter, error) { return tc, nil }
 		loopKs := newKeystore(1)
-		txm := NewTxm(db, tcFn, *gpe, chainID, cfg, loopKs, lggr)
+		txm := NewTxm(db, tcFn, newHeadTracker(tc, lggr), gpe, chainID, cfg, loopKs, lggr)
 		i, err := txm.orm.InsertMsg(ctx, "blah", "", []byte{0x01})
 		require.NoError(t, err)
 		txh := "0x123"
@@ -276,7 +289,7 @@ func TestTxm(t *testing.T) {
 		}, nil).Once()
 		tcFn := func() (client.ReaderWriter, error) { return tc, nil }
 		loopKs := newKeystore(1)
-		txm := NewTxm(db, tcFn, *gpe, chainID, cfg, loopKs, lggr)
+		txm := NewTxm(db, tcFn, newHeadTracker(tc, lggr), gpe, chainID, cfg, loopKs, lggr)
 
 		// Insert and broadcast 3 msgs with different txhashes.
 		id1, err := txm.orm.InsertMsg(ctx, "blah", "", []byte{0x01})
@@ -321,7 +334,7 @@ func TestTxm(t *testing.T) {
 		}}
 		cfgShortExpiry.SetDefaults()
 		loopKs := newKeystore(1)
-		txm := NewTxm(db, tcFn, *gpe, chainID, cfgShortExpiry, loopKs, lggr)
+		txm := NewTxm(db, tcFn, newHeadTracker(tc, lggr), gpe, chainID, cfgShortExpiry, loopKs, lggr)
 
 		// Send a single one expired
 		id1, err := txm.orm.InsertMsg(ctx, "blah", "", []byte{0x03})
@@ -354,9 +367,7 @@ func TestTxm(t *testing.T) {
 		tc.On("SimulateUnsigned", mock.Anything, mock.Anything).Return(&txtypes.SimulateResponse{GasInfo: &cosmostypes.GasInfo{
 			GasUsed: 1_000_000,
 		}}, nil)
-		tc.On("LatestBlock").Return(&tmservicetypes.GetLatestBlockResponse{SdkBlock: &tmservicetypes.Block{
-			Header: tmservicetypes.Header{Height: 1},
-		}}, nil)
+		tc.On("Status").Return(&coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: 1}}, nil)
 		tc.On("CreateAndSign", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte{0x01}, nil)
 		txResp := &cosmostypes.TxResponse{TxHash: "4BF5122F344554C53BDE2EBB8CD2B7E3D1600AD631C385A5D7CCE23C7785459A"}
 		tc.On("Broadcast", mock.Anything, mock.Anything).Return(&txtypes.BroadcastTxResponse{TxResponse: txResp}, nil)
@@ -368,7 +379,7 @@ func TestTxm(t *testing.T) {
 		}}
 		cfgMaxMsgs.SetDefaults()
 		loopKs := newKeystore(1)
-		txm := NewTxm(db, tcFn, *gpe, chainID, cfgMaxMsgs, loopKs, lggr)
+		txm := NewTxm(db, tcFn, newHeadTracker(tc, lggr), gpe, chainID, cfgMaxMsgs, loopKs, lggr)
 
 		// Leftover started is processed
 		msg1 := generateExecuteMsg([]byte{0x03}, sender1, contract)
@@ -413,6 +424,88 @@ func TestTxm(t *testing.T) {
 		assert.Equal(t, cosmosdb.Confirmed, ms[0].State)
 		assert.Equal(t, cosmosdb.Confirmed, ms[1].State)
 	})
+
+	t.Run("gas price unavailable", func(t *testing.T) {
+		ctx := tests.Context(t)
+		tc := newReaderWriterMock(t)
+		tcFn := func() (client.ReaderWriter, error) { return tc, nil }
+		errNoGasPrice := errors.New("no gas price")
+		failing := true
+		failingGpe := client.NewComposedGasPriceEstimator([]client.GasPriceStage{{
+			Estimator: client.NewClosureGasPriceEstimator(func() (map[string]cosmostypes.DecCoin, error) {
+				if failing {
+					return nil, errNoGasPrice
+				}
+				return map[string]cosmostypes.DecCoin{
+					cfg.GasToken(): cosmostypes.NewDecCoinFromDec(cfg.GasToken(), cosmostypes.MustNewDecFromStr("0.01")),
+				}, nil
+			}),
+		}}, lggr)
+		loopKs := newKeystore(1)
+		txm := NewTxm(db, tcFn, newHeadTracker(tc, lggr), failingGpe, chainID, cfg, loopKs, lggr)
+
+		// The batch is skipped, and the txm is unhealthy
+		id1 := mustInsertMsg(t, txm, contract.String(), generateExecuteMsg([]byte{0x06}, sender1, contract))
+		txm.sendMsgBatch(ctx)
+		m, err := txm.orm.GetMsgs(ctx, id1)
+		require.NoError(t, err)
+		assert.Equal(t, cosmosdb.Started, m[0].State)
+		require.ErrorIs(t, txm.HealthReport()[txm.Name()], errNoGasPrice)
+
+		// Once the gas price is available, the batch is sent and the txm is healthy again
+		failing = false
+		msgs := client.SimMsgs{{ID: id1, Msg: &wasmtypes.MsgExecuteContract{
+			Sender:   sender1.String(),
+			Msg:      []byte{0x06},
+			Contract: contract.String(),
+		}}}
+		tc.On("Account", mock.Anything).Return(uint64(0), uint64(0), nil)
+		tc.On("BatchSimulateUnsigned", msgs, mock.Anything).Return(&client.BatchSimResults{Succeeded: msgs}, nil)
+		tc.On("SimulateUnsigned", mock.Anything, mock.Anything).Return(&txtypes.SimulateResponse{GasInfo: &cosmostypes.GasInfo{
+			GasUsed: 1_000_000,
+		}}, nil)
+		tc.On("Status").Return(&coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: 1}}, nil)
+		tc.On("CreateAndSign", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte{0x01}, nil)
+		txResp := &cosmostypes.TxResponse{TxHash: "4BF5122F344554C53BDE2EBB8CD2B7E3D1600AD631C385A5D7CCE23C7785459A"}
+		tc.On("Broadcast", mock.Anything, mock.Anything).Return(&txtypes.BroadcastTxResponse{TxResponse: txResp}, nil)
+		tc.On("Tx", mock.Anything).Return(&txtypes.GetTxResponse{Tx: &txtypes.Tx{}, TxResponse: txResp}, nil)
+		txm.sendMsgBatch(ctx)
+		m, err = txm.orm.GetMsgs(ctx, id1)
+		require.NoError(t, err)
+		assert.Equal(t, cosmosdb.Confirmed, m[0].State)
+		assert.NotErrorIs(t, txm.HealthReport()[txm.Name()], errNoGasPrice)
+	})
+
+	t.Run("refused by fee cap", func(t *testing.T) {
+		ctx := tests.Context(t)
+		tc := newReaderWriterMock(t)
+		tcFn := func() (client.ReaderWriter, error) { return tc, nil }
+		maxFee := decimal.RequireFromString("1")
+		cappedCfg := &config.TOMLConfig{Chain: config.Chain{MaxFeePerTx: &maxFee, GasToken: &gasToken}}
+		cappedCfg.SetDefaults()
+		txm := NewTxm(db, tcFn, newHeadTracker(tc, lggr), gpe, chainID, cappedCfg, newKeystore(1), lggr)
+
+		id1 := mustInsertMsg(t, txm, contract.String(), generateExecuteMsg([]byte{0x07}, sender1, contract))
+		msgs := client.SimMsgs{{ID: id1, Msg: &wasmtypes.MsgExecuteContract{
+			Sender:   sender1.String(),
+			Msg:      []byte{0x07},
+			Contract: contract.String(),
+		}}}
+		tc.On("Account", mock.Anything).Return(uint64(0), uint64(0), nil)
+		tc.On("BatchSimulateUnsigned", msgs, mock.Anything).Return(&client.BatchSimResults{Succeeded: msgs}, nil)
+		tc.On("SimulateUnsigned", mock.Anything, mock.Anything).Return(&txtypes.SimulateResponse{GasInfo: &cosmostypes.GasInfo{
+			GasUsed: 1_000_000,
+		}}, nil)
+		tc.On("Status").Return(&coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: 1}}, nil)
+		txm.sendMsgBatch(ctx)
+
+		// The msg is left Started to be retried, with the reason it was refused
+		m, err := txm.GetMsgs(ctx, id1)
+		require.NoError(t, err)
+		require.Len(t, m, 1)
+		assert.Equal(t, cosmosdb.Started, m[0].State)
+		require.ErrorIs(t, m[0].Refusal, ErrFeeCapExceeded)
+	})
 }
 
 func mustInsertMsg(t *testing.T, txm *Txm, contractID string, msg cosmostypes.Msg) int64 {
@@ -450,3 +543,244 @@ func (k *keystore) Sign(ctx context.Context, account string, data []byte) (signe
 	}
 	return data, nil
 }
+
+func TestTxm_marshalMsg(t *testing.T) {
+	txm := &Txm{lggr: logger.Test(t), bech32: "inj"}
+	sender := params.Bech32Prefix("inj").Address(cosmostypes.AccAddress("sender______________"))
+	for _, msg := range []cosmostypes.Msg{
+		&types.MsgSend{FromAddress: sender, ToAddress: sender, Amount: cosmostypes.NewCoins(cosmostypes.NewInt64Coin("inj", 1))},
+		&wasmtypes.MsgExecuteContract{Sender: sender, Contract: sender, Msg: []byte(`{}`)},
+		&injectivetypes.MsgTransmit{Transmitter: sender, FeedId: "feed", ConfigDigest: []byte{0x01}, Report: &injectivetypes.Report{}},
+	} {
+		typeURL, raw, err := txm.marshalMsg(msg)
+		require.NoError(t, err)
+		decoded, decodedSender, err := unmarshalMsg(typeURL, raw)
+		require.NoError(t, err)
+		assert.Equal(t, sender, decodedSender)
+		assert.Equal(t, typeURL, cosmostypes.MsgTypeURL(decoded))
+	}
+
+	// senders must have the prefix of the chain
+	_, _, err := txm.marshalMsg(&wasmtypes.MsgExecuteContract{Sender: params.Bech32Prefix("wasm").Address(cosmostypes.AccAddress("sender______________"))})
+	require.Error(t, err)
+
+	var unsupported *ErrMsgUnsupported
+	_, _, err = txm.marshalMsg(&types.MsgMultiSend{})
+	require.ErrorAs(t, err, &unsupported)
+}
+
+func TestTxm_feeGasPrice(t *testing.T) {
+	lggr := logger.Test(t)
+	sender := cosmostypes.AccAddress([]byte("sender"))
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	gasPrices := map[string]cosmostypes.DecCoin{
+		"ucosm":  cosmostypes.NewDecCoinFromDec("ucosm", cosmostypes.MustNewDecFromStr("0.01")),
+		ibcDenom: cosmostypes.NewDecCoinFromDec(ibcDenom, cosmostypes.MustNewDecFromStr("0.002")),
+	}
+	newTxm := func(feeDenoms ...string) *Txm {
+		cfg := &config.TOMLConfig{Chain: config.Chain{FeeDenoms: feeDenoms}}
+		cfg.SetDefaults()
+		return &Txm{cfg: cfg, lggr: lggr}
+	}
+	balance := func(denom string, amount int64) *cosmostypes.Coin {
+		coin := cosmostypes.NewInt64Coin(denom, amount)
+		return &coin
+	}
+
+	t.Run("gas token", func(t *testing.T) {
+		tc := newReaderWriterMock(t)
+		gasPrice, err := newTxm().feeGasPrice(tc, gasPrices, sender, 100_000)
+		require.NoError(t, err)
+		assert.Equal(t, gasPrices["ucosm"], gasPrice)
+	})
+
+	t.Run("first affordable denom", func(t *testing.T) {
+		tc := newReaderWriterMock(t)
+		// the fee is 0.01 * 150_000 = 1_500ucosm
+		tc.On("Balance", sender, "ucosm").Return(balance("ucosm", 1_499), nil).Once()
+		tc.On("Balance", sender, ibcDenom).Return(balance(ibcDenom, 300), nil).Once()
+		gasPrice, err := newTxm("ucosm", ibcDenom).feeGasPrice(tc, gasPrices, sender, 100_000)
+		require.NoError(t, err)
+		assert.Equal(t, gasPrices[ibcDenom], gasPrice)
+	})
+
+	t.Run("converted gas price", func(t *testing.T) {
+		tc := newReaderWriterMock(t)
+		tc.On("Balance", sender, "ncosm").Return(balance("ncosm", 1_500_000), nil).Once()
+		gasPrice, err := newTxm("ncosm", "ucosm").feeGasPrice(tc, gasPrices, sender, 100_000)
+		require.NoError(t, err)
+		assert.Equal(t, cosmostypes.NewDecCoinFromDec("ncosm", cosmostypes.MustNewDecFromStr("10")), gasPrice)
+	})
+
+	t.Run("insufficient balances", func(t *testing.T) {
+		tc := newReaderWriterMock(t)
+		tc.On("Balance", sender, "ucosm").Return(balance("ucosm", 0), nil).Once()
+		tc.On("Balance", sender, ibcDenom).Return(nil, errors.New("unreachable")).Once()
+		_, err := newTxm("ucosm", ibcDenom, "uatom").feeGasPrice(tc, gasPrices, sender, 100_000)
+		require.ErrorContains(t, err, "balance 0ucosm is less than fee 1500ucosm")
+		require.ErrorContains(t, err, "failed to get "+ibcDenom+" balance: unreachable")
+		require.ErrorContains(t, err, "no gas price for uatom")
+	})
+}
+
+func TestTxm_checkFee(t *testing.T) {
+	lggr := logger.Test(t)
+	newTxm := func(maxFee, budget string) *Txm {
+		maxFeeDec, budgetDec := decimal.RequireFromString(maxFee), decimal.RequireFromString(budget)
+		cfg := &config.TOMLConfig{Chain: config.Chain{MaxFeePerTx: &maxFeeDec, FeeBudget: &budgetDec}}
+		cfg.SetDefaults()
+		return &Txm{cfg: cfg, lggr: lggr, feeBudget: newFeeBudget()}
+	}
+
+	t.Run("unlimited", func(t *testing.T) {
+		txm := newTxm("0", "0")
+		// still returned, to be recorded for the balance runway
+		fee, err := txm.checkFee("sender", cosmostypes.NewInt64Coin("ucosm", 1_000_000_000))
+		require.NoError(t, err)
+		assert.Equal(t, cosmostypes.NewDec(1_000_000_000), fee)
+		// fees in denoms which cannot be converted are not refused either
+		fee, err = txm.checkFee("sender", cosmostypes.NewInt64Coin("uatom", 1))
+		require.NoError(t, err)
+		assert.True(t, fee.IsZero())
+	})
+
+	t.Run("max fee per tx", func(t *testing.T) {
+		txm := newTxm("1000", "0")
+		fee, err := txm.checkFee("sender", cosmostypes.NewInt64Coin("ucosm", 1000))
+		require.NoError(t, err)
+		assert.Equal(t, cosmostypes.NewDec(1000), fee)
+		// converted to the gas token
+		_, err = txm.checkFee("sender", cosmostypes.NewInt64Coin("ncosm", 1_000_001))
+		require.ErrorIs(t, err, ErrFeeCapExceeded)
+		_, err = txm.checkFee("sender", cosmostypes.NewInt64Coin("uatom", 1))
+		require.ErrorContains(t, err, "unable to convert fee 1uatom to ucosm")
+	})
+
+	t.Run("budget", func(t *testing.T) {
+		txm := newTxm("0", "1500")
+		for i := 0; i < 3; i++ {
+			fee, err := txm.checkFee("sender", cosmostypes.NewInt64Coin("ucosm", 500))
+			require.NoError(t, err)
+			txm.feeBudget.record("sender", fee)
+		}
+		_, err := txm.checkFee("sender", cosmostypes.NewInt64Coin("ucosm", 1))
+		require.ErrorIs(t, err, ErrFeeBudgetExceeded)
+		// other senders have their own budget
+		_, err = txm.checkFee("other", cosmostypes.NewInt64Coin("ucosm", 1500))
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]cosmostypes.Dec{"sender": cosmostypes.NewDec(1500)}, txm.FeeBudgetUsage())
+		report := txm.HealthReport()
+		require.Contains(t, report, txm.Name()+".FeeBudget.sender")
+		require.ErrorContains(t, report[txm.Name()+".FeeBudget.sender"], "spent 1500.000000000000000000 of fee budget 1500.000000000000000000ucosm in the last 24h0m0s")
+	})
+}
+
+func TestTxm_gasTokens(t *testing.T) {
+	// a second chain registers the denominations of its own gas token, next to the cosm ones of TestMain
+	require.NoError(t, params.RegisterDenoms("inj"))
+	lggr := logger.Test(t)
+	newTxm := func(gasToken string) *Txm {
+		maxFee := decimal.RequireFromString("1000")
+		cfg := &config.TOMLConfig{Chain: config.Chain{GasToken: &gasToken, MaxFeePerTx: &maxFee}}
+		cfg.SetDefaults()
+		return &Txm{cfg: cfg, lggr: lggr, feeBudget: newFeeBudget()}
+	}
+
+	for _, tt := range []struct {
+		gasToken, gasPrice, feeDenom, convertedPrice string
+		maxFee, overMaxFee                           int64
+	}{
+		{"ucosm", "0.01", "ncosm", "10", 1_000_000, 1_000_001},
+		{"inj", "0.000000001", "ninj", "1", 1_000_000_000_000, 1_000_000_000_001},
+	} {
+		t.Run(tt.gasToken, func(t *testing.T) {
+			txm := newTxm(tt.gasToken)
+			gasPrices := map[string]cosmostypes.DecCoin{
+				tt.gasToken: cosmostypes.NewDecCoinFromDec(tt.gasToken, cosmostypes.MustNewDecFromStr(tt.gasPrice)),
+			}
+			gasPrice, err := txm.gasPriceIn(gasPrices, tt.feeDenom)
+			require.NoError(t, err)
+			assert.Equal(t, cosmostypes.NewDecCoinFromDec(tt.feeDenom, cosmostypes.MustNewDecFromStr(tt.convertedPrice)), gasPrice)
+
+			fee, err := txm.checkFee("sender", cosmostypes.NewInt64Coin(tt.feeDenom, tt.maxFee))
+			require.NoError(t, err)
+			assert.Equal(t, cosmostypes.NewDec(1000), fee)
+			_, err = txm.checkFee("sender", cosmostypes.NewInt64Coin(tt.feeDenom, tt.overMaxFee))
+			require.ErrorIs(t, err, ErrFeeCapExceeded)
+		})
+	}
+}
+
+func TestTxm_BalanceRunway(t *testing.T) {
+	lggr := logger.Test(t)
+	cfg := &config.TOMLConfig{}
+	cfg.SetDefaults()
+	// the loop keystore holds hex pubkeys, which the txm derives addresses from
+	ks := &keystore{accounts: []string{hex.EncodeToString(secp256k1.GenPrivKey().PubKey().Bytes())}}
+	txm := NewTxm(nil, nil, nil, nil, RandomChainID(), cfg, ks, lggr)
+	accounts, err := txm.Accounts()
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	sender, err := cosmostypes.AccAddressFromBech32(accounts[0])
+	require.NoError(t, err)
+
+	chain := fakechain.New(fakechain.Config{})
+	chain.Fund(sender, cosmostypes.NewInt64Coin(cfg.GasToken(), 1_000_000))
+	m := monitor.NewBalanceMonitor("chain", cfg, txm, func() (client.Reader, error) { return chain, nil }, lggr)
+
+	// fees are recorded without MaxFeePerTx or FeeBudget, as when a tx is broadcast
+	fee, err := txm.checkFee(accounts[0], cosmostypes.NewInt64Coin(cfg.GasToken(), 1_000))
+	require.NoError(t, err)
+	txm.feeBudget.record(accounts[0], fee)
+
+	require.NoError(t, m.Start(tests.Context(t)))
+	t.Cleanup(func() { require.NoError(t, m.Close()) })
+	require.Eventually(t, func() bool {
+		_, ok := m.Balances()[accounts[0]]
+		return ok
+	}, tests.WaitTimeout(t), 10*time.Millisecond)
+	assert.Equal(t, 1000*cfg.FeeBudgetPeriod(), m.Balances()[accounts[0]].Runway)
+}
+
+func TestRefusals(t *testing.T) {
+	now := time.Now()
+	r := newRefusals()
+	r.now = func() time.Time { return now }
+	r.refuse([]int64{1, 2}, ErrFeeCapExceeded, time.Hour)
+	now = now.Add(time.Minute)
+	r.refuse([]int64{2}, ErrFeeBudgetExceeded, time.Hour)
+
+	msgs := adapters.Msgs{{Msg: cosmosdb.Msg{ID: 1}}, {Msg: cosmosdb.Msg{ID: 2}}, {Msg: cosmosdb.Msg{ID: 3}}}
+	r.annotate(msgs)
+	assert.ErrorIs(t, msgs[0].Refusal, ErrFeeCapExceeded)
+	assert.ErrorIs(t, msgs[1].Refusal, ErrFeeBudgetExceeded) // the last refusal
+	assert.NoError(t, msgs[2].Refusal)
+
+	// sent msgs are forgotten
+	r.clear([]int64{2})
+	msgs = adapters.Msgs{{Msg: cosmosdb.Msg{ID: 2}}}
+	r.annotate(msgs)
+	assert.NoError(t, msgs[0].Refusal)
+
+	// and so are refusals older than the retention
+	now = now.Add(2 * time.Hour)
+	r.refuse([]int64{3}, ErrFeeCapExceeded, time.Hour)
+	assert.NotContains(t, r.msgs, int64(1))
+	assert.Contains(t, r.msgs, int64(3))
+}
+
+func TestFeeBudget(t *testing.T) {
+	now := time.Now()
+	b := newFeeBudget()
+	b.now = func() time.Time { return now }
+	b.record("sender", cosmostypes.NewDec(1))
+	now = now.Add(time.Minute)
+	b.record("sender", cosmostypes.NewDec(2))
+	assert.Equal(t, cosmostypes.NewDec(3), b.spentBy("sender", time.Hour))
+	// older spending is forgotten
+	assert.Equal(t, cosmostypes.NewDec(2), b.spentBy("sender", 30*time.Second))
+	assert.Equal(t, cosmostypes.NewDec(2), b.spentBy("sender", time.Hour))
+	now = now.Add(time.Hour)
+	assert.Empty(t, b.usage(time.Hour))
+}
@@ -14,13 +14,13 @@ package txm_test
 //	})
 //	lggr := logger.TestLogger(t)
 //	logCfg := pgtest.NewQConfig(true)
-//	gpe := cosmosclient.NewMustGasPriceEstimator([]cosmosclient.GasPricesEstimator{
-//		cosmosclient.NewFixedGasPriceEstimator(map[string]sdk.DecCoin{
+//	gpe := cosmosclient.NewComposedGasPriceEstimator([]cosmosclient.GasPriceStage{{
+//		Estimator: cosmosclient.NewFixedGasPriceEstimator(map[string]sdk.DecCoin{
 //			*cosmosChain.GasToken: fallbackGasPrice,
 //		},
 //			lggr.(logger.SugaredLogger),
 //		),
-//	}, lggr)
+//	}}, lggr)
 //	orm := cosmostxm.NewORM(chainID, db, lggr, logCfg)
 //	eb := pg.NewEventBroadcaster(cfg.Database().URL(), 0, 0, lggr, uuid.New())
 //	require.NoError(t, eb.Start(testutils.Context(t)))
@@ -13,36 +13,63 @@ import (
 	"go.uber.org/ratelimit"
 
 	pkgClient "github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/client"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/contracts"
 )
 
 // ChainReader is a subset of the pkg/cosmos/client.Reader interface enhanced with context support.
 type ChainReader interface {
 	TxsEvents(ctx context.Context, events []string, paginationParams *query.PageRequest) (*txtypes.GetTxsEventResponse, error)
 	ContractState(ctx context.Context, contractAddress sdk.AccAddress, queryMsg []byte) ([]byte, error)
+	RawContractState(ctx context.Context, contractAddress sdk.AccAddress, key []byte) ([]byte, error)
+}
+
+// contractQuerier adapts a ChainReader to the contracts.Querier used by typed contract clients.
+type contractQuerier struct {
+	ctx    context.Context
+	reader ChainReader
+}
+
+var _ contracts.Querier = contractQuerier{}
+
+func (q contractQuerier) ContractState(contractAddress sdk.AccAddress, queryMsg []byte) ([]byte, error) {
+	return q.reader.ContractState(q.ctx, contractAddress, queryMsg)
+}
+
+func (q contractQuerier) RawContractState(contractAddress sdk.AccAddress, key []byte) ([]byte, error) {
+	return q.reader.RawContractState(q.ctx, contractAddress, key)
 }
 
 // NewChainReader produces a ChainReader that issues requests to the Cosmos RPC
 // in sequence, even if it's called by multiple sources in parallel.
 // That's because the Cosmos endpoint is aggresively rate limitting the monitor.
+// Contract queries from all sources are batched, so that the requests to the Cosmos RPC do not grow with the number of feeds.
+// Unlike the chain, the monitor runs no client.HeadTracker: no source reads the latest height, since block numbers
+// are those of the txs and config blocks being reported, so polling for heads would only spend the rate limit.
 func NewChainReader(cosmosConfig CosmosConfig, coreLog logger.Logger) ChainReader {
-	return &chainReader{
-		cosmosConfig,
-		coreLog,
-		sync.Mutex{},
-		ratelimit.New(
+	c := &chainReader{
+		cosmosConfig: cosmosConfig,
+		coreLog:      coreLog,
+		rateLimiter: ratelimit.New(
 			cosmosConfig.TendermintReqsPerSec,
 			ratelimit.Per(1*time.Second),
 			ratelimit.WithoutSlack, // don't accumulate previously "unspent" requests for future bursts
 		),
 	}
+	c.batcher = pkgClient.NewContractStateBatcher(c.batchContractState, contractQueryBatchWindow, pkgClient.DefaultMaxBatchSize)
+	return c
 }
 
+// contractQueryBatchWindow is how long contract queries wait for others to be batched with.
+// Sources are polled at the same time, so their queries arrive together.
+const contractQueryBatchWindow = 100 * time.Millisecond
+
 type chainReader struct {
 	cosmosConfig CosmosConfig
 	coreLog      logger.Logger
 
 	globalSequencer sync.Mutex
 	rateLimiter     ratelimit.Limiter
+	batcher         *pkgClient.ContractStateBatcher
 }
 
 func (c *chainReader) TxsEvents(_ context.Context, events []string, paginationParams *query.PageRequest) (*txtypes.GetTxsEventResponse, error) {
@@ -62,6 +89,26 @@ func (c *chainReader) TxsEvents(_ context.Context, events []string, paginationPa
 }
 
 func (c *chainReader) ContractState(_ context.Context, contractAddress sdk.AccAddress, queryMsg []byte) ([]byte, error) {
+	return c.batcher.ContractState(contractAddress, queryMsg)
+}
+
+func (c *chainReader) batchContractState(queries []pkgClient.ContractQuery) ([]pkgClient.ContractQueryResult, error) {
+	c.globalSequencer.Lock()
+	defer c.globalSequencer.Unlock()
+	client, err := pkgClient.NewClient(
+		c.cosmosConfig.ChainID,
+		c.cosmosConfig.TendermintURL,
+		c.cosmosConfig.ReadTimeout,
+		c.coreLog,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a cosmos client: %w", err)
+	}
+	_ = c.rateLimiter.Take()
+	return client.BatchContractState(queries)
+}
+
+func (c *chainReader) RawContractState(_ context.Context, contractAddress sdk.AccAddress, key []byte) ([]byte, error) {
 	c.globalSequencer.Lock()
 	defer c.globalSequencer.Unlock()
 	client, err := pkgClient.NewClient(
@@ -74,5 +121,5 @@ func (c *chainReader) ContractState(_ context.Context, contractAddress sdk.AccAd
 		return nil, fmt.Errorf("failed to create a cosmos client: %w", err)
 	}
 	_ = c.rateLimiter.Take()
-	return client.ContractState(contractAddress, queryMsg)
+	return client.RawContractState(contractAddress, key)
 }
@@ -14,8 +14,11 @@ type Tx struct {
 	ID     uint64 `json:"id"`
 	Height string `json:"height"`
 	Code   int    `json:"code"` // Error code if present
+	// Logs and RawLog are only populated by SDK versions before v0.50.
 	Logs   []Log  `json:"logs"`
 	RawLog string `json:"raw_log"`
+	// Events contains all tx events since SDK v0.47, and is the only place to find them since v0.50.
+	Events []Event `json:"events"`
 }
 
 type Log struct {
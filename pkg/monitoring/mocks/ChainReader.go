@@ -44,6 +44,32 @@ func (_m *ChainReader) ContractState(ctx context.Context, contractAddress types.
 	return r0, r1
 }
 
+// RawContractState provides a mock function with given fields: ctx, contractAddress, key
+func (_m *ChainReader) RawContractState(ctx context.Context, contractAddress types.AccAddress, key []byte) ([]byte, error) {
+	ret := _m.Called(ctx, contractAddress, key)
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.AccAddress, []byte) ([]byte, error)); ok {
+		return rf(ctx, contractAddress, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.AccAddress, []byte) []byte); ok {
+		r0 = rf(ctx, contractAddress, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.AccAddress, []byte) error); ok {
+		r1 = rf(ctx, contractAddress, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // TxsEvents provides a mock function with given fields: ctx, events, paginationParams
 func (_m *ChainReader) TxsEvents(ctx context.Context, events []string, paginationParams *query.PageRequest) (*tx.GetTxsEventResponse, error) {
 	ret := _m.Called(ctx, events, paginationParams)
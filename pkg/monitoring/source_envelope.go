@@ -8,7 +8,6 @@ import (
 	"math/big"
 	"sort"
 	"strconv"
-	"strings"
 	"sync"
 	"time"
 
@@ -17,6 +16,7 @@ import (
 	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/adapters/cosmwasm"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/contracts"
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/monitoring/fcdclient"
 )
 
@@ -246,18 +246,10 @@ func (e *envelopeSource) fetchLatestConfig(ctx context.Context) (types.ContractC
 }
 
 func (e *envelopeSource) fetchLatestConfigBlock(ctx context.Context) (uint64, error) {
-	resp, err := e.rpcClient.ContractState(
-		ctx,
-		e.cosmosFeedConfig.ContractAddress,
-		[]byte(`{"latest_config_details":{}}`),
-	)
-	var details cosmwasm.ConfigDetails
+	details, err := contracts.NewOCR2(e.cosmosFeedConfig.ContractAddress, contractQuerier{ctx, e.rpcClient}).LatestConfigDetails()
 	if err != nil {
 		return 0, fmt.Errorf("failed to fetch config details: %w", err)
 	}
-	if err = json.Unmarshal(resp, &details); err != nil {
-		return 0, fmt.Errorf("failed to unmarshal config details: %w", err)
-	}
 	return details.BlockNumber, nil
 }
 
@@ -344,28 +336,12 @@ func (e *envelopeSource) fetchLinkBalance(ctx context.Context) (*big.Int, error)
 	return balance, nil
 }
 
-type linkAvailableForPaymentRes struct {
-	Amount string `json:"amount,omitempty"`
-}
-
 func (e *envelopeSource) fetchLinkAvailableForPayment(ctx context.Context) (*big.Int, error) {
-	res, err := e.rpcClient.ContractState(
-		ctx,
-		e.cosmosFeedConfig.ContractAddress,
-		[]byte(`{"link_available_for_payment":{}}`),
-	)
+	amount, err := contracts.NewOCR2(e.cosmosFeedConfig.ContractAddress, contractQuerier{ctx, e.rpcClient}).LinkAvailableForPayment()
 	if err != nil {
 		return nil, fmt.Errorf("failed to read link_available_for_payment from the contract: %w", err)
 	}
-	linkAvailableForPayment := linkAvailableForPaymentRes{}
-	if err := json.Unmarshal(res, &linkAvailableForPayment); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal link available data from the response '%s': %w", string(res), err)
-	}
-	amount, success := new(big.Int).SetString(linkAvailableForPayment.Amount, 10)
-	if !success {
-		return nil, fmt.Errorf("failed to parse amount of link available for payment from string '%s' into a big.Int", linkAvailableForPayment.Amount)
-	}
-	return amount, nil
+	return amount.Int, nil
 }
 
 // Helpers
@@ -411,16 +387,14 @@ func extractMatchingEvents(res fcdclient.Response, eventType, contractAddressBec
 		return res.Txs[i].ID > res.Txs[j].ID
 	})
 	for _, tx := range res.Txs {
-		if !strings.Contains(tx.RawLog, fmt.Sprintf(`"type":"%s"`, eventType)) {
-			continue
-		}
-		for _, event := range tx.Logs[0].Events {
+		for _, event := range txEvents(tx) {
 			if event.Typ != eventType {
 				continue
 			}
 			isMatchingContractAddress := false
 			for _, attribute := range event.Attributes {
-				if attribute.Key == "contract_address" && attribute.Value == contractAddressBech32 {
+				// older wasmd versions use contract_address, newer ones _contract_address
+				if (attribute.Key == "contract_address" || attribute.Key == "_contract_address") && attribute.Value == contractAddressBech32 {
 					isMatchingContractAddress = true
 					break
 				}
@@ -433,6 +407,18 @@ func extractMatchingEvents(res fcdclient.Response, eventType, contractAddressBec
 	return out
 }
 
+// txEvents returns the events emitted by tx, from the flat events if present, or from the logs of every msg otherwise.
+func txEvents(tx fcdclient.Tx) []fcdclient.Event {
+	if len(tx.Events) > 0 {
+		return tx.Events
+	}
+	var events []fcdclient.Event
+	for _, log := range tx.Logs {
+		events = append(events, log.Events...)
+	}
+	return events
+}
+
 func checkEventAttributes(
 	event fcdclient.Event,
 	extractors map[string]func(string) error,
@@ -16,6 +16,7 @@ import (
 
 	relayMonitoring "github.com/smartcontractkit/chainlink-common/pkg/monitoring"
 
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/contracts"
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/monitoring/fcdclient"
 	fcdclientmocks "github.com/smartcontractkit/chainlink-cosmos/pkg/monitoring/fcdclient/mocks"
 	"github.com/smartcontractkit/chainlink-cosmos/pkg/monitoring/mocks"
@@ -186,3 +187,68 @@ func mustHexaToByteArr(encoded string) []byte {
 	}
 	return decoded
 }
+
+func TestEnvelopeSource_EventFormats(t *testing.T) {
+	readResponse := func(t *testing.T, path string) fcdclient.Response {
+		raw, err := os.ReadFile(path)
+		require.NoError(t, err)
+		res := fcdclient.Response{}
+		require.NoError(t, json.Unmarshal(raw, &res))
+		return res
+	}
+	newSource := func(t *testing.T, dir, contract string, configBlock uint64) *envelopeSource {
+		feedConfig := generateFeedConfig()
+		feedConfig.ContractAddressBech32 = contract
+		// the address of the legacy fixtures has an invalid checksum, and is only matched as a string
+		feedConfig.ContractAddress, _ = sdk.AccAddressFromBech32(contract)
+		fcdClient := new(fcdclientmocks.Client)
+		fcdClient.On("GetTxList", mock.Anything, fcdclient.GetTxListParams{Account: feedConfig.ContractAddress, Limit: 10}).
+			Return(readResponse(t, dir+"/new_transmission-txs.json"), nil).Once()
+		fcdClient.On("GetBlockAtHeight", mock.Anything, configBlock).
+			Return(readResponse(t, dir+"/set_config-block.json"), nil).Once()
+		return &envelopeSource{fcdClient: fcdClient, log: newNullLogger(), cosmosFeedConfig: feedConfig}
+	}
+
+	t.Run("legacy logs", func(t *testing.T) {
+		source := newSource(t, "./fixtures", "wasm10kc4n52rk4xqny3hdew3ggjfk9r420pqxs9ylf", 6805892)
+		transmission, err := source.fetchLatestTransmission(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, uint64(7364948), transmission.blockNumber)
+		require.Equal(t, uint32(44554), transmission.epoch)
+		require.Equal(t, big.NewInt(295998430000), transmission.latestAnswer)
+		config, err := source.fetchLatestConfigFromLogs(context.Background(), 6805892)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), config.ConfigCount)
+		require.Equal(t, uint8(5), config.F)
+	})
+
+	// ./fixtures/v0.50 is captured from a v0.50 wasmd node by scripts/capture-v0.50-fixtures.sh, with the
+	// expected values read from the contract state.
+	t.Run("v0.50 flat events", func(t *testing.T) {
+		raw, err := os.ReadFile("./fixtures/v0.50/expected.json")
+		if os.IsNotExist(err) {
+			t.Skip("no v0.50 fixtures, capture them with scripts/capture-v0.50-fixtures.sh")
+		}
+		require.NoError(t, err)
+		var expected struct {
+			Contract                  string                              `json:"contract"`
+			Height                    uint64                              `json:"height"`
+			LatestConfigDetails       contracts.LatestConfigDetails       `json:"latest_config_details"`
+			LatestTransmissionDetails contracts.LatestTransmissionDetails `json:"latest_transmission_details"`
+		}
+		require.NoError(t, json.Unmarshal(raw, &expected))
+
+		source := newSource(t, "./fixtures/v0.50", expected.Contract, expected.LatestConfigDetails.BlockNumber)
+		transmission, err := source.fetchLatestTransmission(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, expected.Height, transmission.blockNumber)
+		require.Equal(t, expected.LatestTransmissionDetails.LatestConfigDigest, transmission.configDigest)
+		require.Equal(t, expected.LatestTransmissionDetails.Epoch, transmission.epoch)
+		require.Equal(t, expected.LatestTransmissionDetails.Round, transmission.round)
+		require.Equal(t, expected.LatestTransmissionDetails.LatestAnswer.Int, transmission.latestAnswer)
+		config, err := source.fetchLatestConfigFromLogs(context.Background(), expected.LatestConfigDetails.BlockNumber)
+		require.NoError(t, err)
+		require.Equal(t, uint64(expected.LatestConfigDetails.ConfigCount), config.ConfigCount)
+		require.Equal(t, expected.LatestConfigDetails.ConfigDigest, config.ConfigDigest)
+	})
+}
@@ -2,11 +2,12 @@ package monitoring
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"math/big"
 
 	relayMonitoring "github.com/smartcontractkit/chainlink-common/pkg/monitoring"
+
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/contracts"
 )
 
 // ProxyData is a subset of the data returned by the Cosmos feed proxy contract's "latest_round_data" method.
@@ -67,27 +68,10 @@ func (p *proxySource) Fetch(ctx context.Context) (interface{}, error) {
 	return ProxyData{answer}, nil
 }
 
-// latestRoundDataRes corresponds to a subset of the Round type in the proxy contract.
-type latestRoundDataRes struct {
-	Answer string `json:"answer,omitempty"`
-}
-
 func (p *proxySource) fetchLatestRoundFromProxy(ctx context.Context) (*big.Int, error) {
-	res, err := p.client.ContractState(
-		ctx,
-		p.cosmosFeedConfig.ProxyAddress,
-		[]byte(`{"latest_round_data":{}}`),
-	)
+	round, err := contracts.NewProxyOCR2(p.cosmosFeedConfig.ProxyAddress, contractQuerier{ctx, p.client}).LatestRoundData()
 	if err != nil {
 		return nil, fmt.Errorf("failed to read latest_round_data from the proxy contract: %w", err)
 	}
-	latestRoundData := latestRoundDataRes{}
-	if err := json.Unmarshal(res, &latestRoundData); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal round data from the response '%s': %w", string(res), err)
-	}
-	answer, success := new(big.Int).SetString(latestRoundData.Answer, 10)
-	if !success {
-		return nil, fmt.Errorf("failed to parse proxy answer '%s' into a big.Int", latestRoundData.Answer)
-	}
-	return answer, nil
+	return round.Answer.Int, nil
 }
@@ -0,0 +1,46 @@
+#!/usr/bin/env bash
+# Captures the SDK v0.50 event fixtures of the monitoring and cosmwasm tests from a v0.50 wasmd node, whose
+# ocr2 contract has run set_config and transmit:
+#
+#   scripts/capture-v0.50-fixtures.sh http://localhost:1317 wasm1...
+#
+# The expected values are read from the contract state at the height of the latest transmission, so they do not
+# depend on the event parsing under test.
+
+set -euo pipefail
+
+lcd="${1:?usage: $0 <lcd url> <ocr2 contract address>}"
+contract="${2:?usage: $0 <lcd url> <ocr2 contract address>}"
+root="$(cd "$(dirname -- "$0")/.." && pwd)"
+monitoring="${root}/pkg/monitoring/fixtures/v0.50"
+cosmwasm="${root}/pkg/cosmos/adapters/cosmwasm/testdata/v0.50"
+mkdir -p "${monitoring}" "${cosmwasm}"
+
+txs() {
+	curl -fsSG "${lcd}/cosmos/tx/v1beta1/txs" --data-urlencode "query=$1" \
+		--data-urlencode "order_by=ORDER_BY_DESC" --data-urlencode "limit=10"
+}
+
+smart() {
+	curl -fsS -H "x-cosmos-block-height: $2" \
+		"${lcd}/cosmwasm/wasm/v1/contract/${contract}/smart/$(printf '{"%s":{}}' "$1" | base64 | tr -d '\n')" | jq '.data'
+}
+
+transmissions="$(txs "wasm-new_transmission._contract_address='${contract}'")"
+height="$(jq -r '.tx_responses[0].height' <<<"${transmissions}")"
+config_details="$(smart latest_config_details "${height}")"
+config_block="$(jq -r '.block_number' <<<"${config_details}")"
+config_txs="$(txs "tx.height=${config_block}")"
+expected="$(jq -n --arg contract "${contract}" --argjson height "${height}" \
+	--argjson config "${config_details}" --argjson transmission "$(smart latest_transmission_details "${height}")" \
+	'{contract: $contract, height: $height, latest_config_details: $config, latest_transmission_details: $transmission}')"
+
+jq '{txs: .tx_responses}' <<<"${transmissions}" >"${monitoring}/new_transmission-txs.json"
+jq '{txs: .tx_responses}' <<<"${config_txs}" >"${monitoring}/set_config-block.json"
+echo "${expected}" >"${monitoring}/expected.json"
+
+jq '{set_config: [.tx_responses[].events[]], new_transmission: []}' <<<"${config_txs}" >"${cosmwasm}/events.json.tmp"
+jq --slurpfile tmp "${cosmwasm}/events.json.tmp" '$tmp[0] + {new_transmission: .tx_responses[0].events}' \
+	<<<"${transmissions}" >"${cosmwasm}/events.json"
+rm "${cosmwasm}/events.json.tmp"
+echo "${expected}" >"${cosmwasm}/expected.json"